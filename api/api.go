@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+const dateFormat = "2006-01-02"
+
+// API provides the http endpoints for triggering on-demand checker runs and, if a history store is configured,
+// querying the results of previous runs
+type API struct {
+	Runner  *Runner
+	History *history.Store
+}
+
+// CheckRequest is the body of a request to trigger a scoped checker run. All fields are optional. Checks names the
+// checks to run in place of those configured.
+type CheckRequest struct {
+	Collection string   `json:"collection"`
+	From       string   `json:"from"`
+	To         string   `json:"to"`
+	URIPrefix  string   `json:"uri_prefix"`
+	Checks     []string `json:"checks"`
+}
+
+// Router returns a handler serving the API endpoints
+func (a *API) Router() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /checks", a.postCheck)
+	mux.HandleFunc("GET /checks/{id}", a.getCheck)
+	if a.History != nil {
+		mux.HandleFunc("GET /history/findings", a.getFindingTrends)
+		mux.HandleFunc("GET /history/runs", a.getDailyRuns)
+	}
+	return mux
+}
+
+func (a *API) postCheck(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body CheckRequest
+	if req.ContentLength != 0 {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			log.Info(ctx, "invalid check request body", log.Data{"error": err.Error()})
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	scope, err := body.scope()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	checks, err := body.checks()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	run, err := a.Runner.Submit(ctx, scope, checks)
+	if err != nil {
+		log.Error(ctx, "unable to queue checker run", err)
+		if err == ErrQueueFull {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		http.Error(w, "unable to queue checker run", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Location", "/checks/"+run.ID)
+	writeJSON(ctx, w, http.StatusAccepted, run)
+}
+
+func (a *API) getCheck(w http.ResponseWriter, req *http.Request) {
+	run, ok := a.Runner.Get(req.PathValue("id"))
+	if !ok {
+		http.Error(w, "run not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(req.Context(), w, http.StatusOK, run)
+}
+
+func (a *API) getFindingTrends(w http.ResponseWriter, req *http.Request) {
+	records, ok := a.historyRecords(w, req)
+	if !ok {
+		return
+	}
+	writeJSON(req.Context(), w, http.StatusOK, history.FindingTrends(records))
+}
+
+func (a *API) getDailyRuns(w http.ResponseWriter, req *http.Request) {
+	records, ok := a.historyRecords(w, req)
+	if !ok {
+		return
+	}
+	writeJSON(req.Context(), w, http.StatusOK, history.DailyRunCounts(records))
+}
+
+// historyRecords reads the stored runs within the optional 'from' and 'to' date query parameters, writing an error
+// response and returning false if they cannot be read
+func (a *API) historyRecords(w http.ResponseWriter, req *http.Request) ([]history.Record, bool) {
+	ctx := req.Context()
+
+	var from, to time.Time
+	var err error
+	if v := req.URL.Query().Get("from"); v != "" {
+		if from, err = time.Parse(dateFormat, v); err != nil {
+			http.Error(w, "from must be a date in the format "+dateFormat, http.StatusBadRequest)
+			return nil, false
+		}
+	}
+	if v := req.URL.Query().Get("to"); v != "" {
+		if to, err = time.Parse(dateFormat, v); err != nil {
+			http.Error(w, "to must be a date in the format "+dateFormat, http.StatusBadRequest)
+			return nil, false
+		}
+		to = to.AddDate(0, 0, 1).Add(-time.Nanosecond) // include the whole of the final day
+	}
+
+	records, err := a.History.Runs(ctx, from, to)
+	if err != nil {
+		log.Error(ctx, "unable to read history store", err)
+		http.Error(w, "unable to read history store", http.StatusInternalServerError)
+		return nil, false
+	}
+	return records, true
+}
+
+func (r CheckRequest) scope() (checker.Scope, error) {
+	scope := checker.Scope{
+		Collection: strings.TrimSpace(r.Collection),
+		URIPrefix:  strings.TrimSpace(r.URIPrefix),
+	}
+
+	var err error
+	if r.From != "" {
+		if scope.From, err = time.Parse(dateFormat, r.From); err != nil {
+			return scope, errors.New("from must be a date in the format " + dateFormat)
+		}
+	}
+	if r.To != "" {
+		if scope.To, err = time.Parse(dateFormat, r.To); err != nil {
+			return scope, errors.New("to must be a date in the format " + dateFormat)
+		}
+	}
+	if !scope.From.IsZero() && !scope.To.IsZero() && scope.To.Before(scope.From) {
+		return scope, errors.New("to must not be before from")
+	}
+	if strings.Contains(scope.URIPrefix, "..") {
+		return scope, errors.New("uri_prefix must not contain '..'")
+	}
+	return scope, nil
+}
+
+func (r CheckRequest) checks() ([]string, error) {
+	if len(r.Checks) == 0 {
+		return nil, nil
+	}
+	checks := make([]string, 0, len(r.Checks))
+	for _, id := range r.Checks {
+		checks = append(checks, strings.TrimSpace(id))
+	}
+	if err := checker.ValidateChecks(checks); err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, status int, body interface{}) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		log.Error(ctx, "unable to marshal response body", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err = w.Write(b); err != nil {
+		log.Error(ctx, "unable to write response body", err)
+	}
+}
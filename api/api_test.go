@@ -0,0 +1,282 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/api"
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+func TestPostCheck(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given an API with a runner that has not been started", t, func() {
+		runner := api.NewRunner(func() *checker.Checker { return &checker.Checker{} })
+		a := &api.API{Runner: runner}
+
+		Convey("When a scoped check is requested", func() {
+			w := doRequest(a, http.MethodPost, "/checks",
+				`{"collection": "col1", "from": "2023-02-01", "to": "2023-02-09", "uri_prefix": "/economy"}`)
+
+			Convey("Then the run is accepted with the requested scope", func() {
+				So(w.Code, ShouldEqual, http.StatusAccepted)
+				run := decodeRun(w)
+				So(run.ID, ShouldNotBeEmpty)
+				So(w.Header().Get("Location"), ShouldEqual, "/checks/"+run.ID)
+				So(run.State, ShouldEqual, api.StateQueued)
+				So(run.Scope, ShouldResemble, checker.Scope{
+					Collection: "col1",
+					From:       time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+					To:         time.Date(2023, 2, 9, 0, 0, 0, 0, time.UTC),
+					URIPrefix:  "/economy",
+				})
+			})
+
+			Convey("And the same check is requested again", func() {
+				first := decodeRun(w)
+				w2 := doRequest(a, http.MethodPost, "/checks",
+					`{"collection": "col1", "from": "2023-02-01", "to": "2023-02-09", "uri_prefix": "/economy"}`)
+
+				Convey("Then the second request is coalesced into the first run", func() {
+					So(w2.Code, ShouldEqual, http.StatusAccepted)
+					So(decodeRun(w2).ID, ShouldEqual, first.ID)
+				})
+			})
+
+			Convey("And a different check is requested", func() {
+				first := decodeRun(w)
+				w2 := doRequest(a, http.MethodPost, "/checks", `{"collection": "col2"}`)
+
+				Convey("Then a new run is queued", func() {
+					So(w2.Code, ShouldEqual, http.StatusAccepted)
+					So(decodeRun(w2).ID, ShouldNotEqual, first.ID)
+				})
+			})
+		})
+
+		Convey("When a check of a master subtree is requested with the checks to run", func() {
+			w := doRequest(a, http.MethodPost, "/checks", `{"uri_prefix": "/economy", "checks": ["uri-names", " schemas"]}`)
+
+			Convey("Then the run is accepted with the requested checks", func() {
+				So(w.Code, ShouldEqual, http.StatusAccepted)
+				run := decodeRun(w)
+				So(run.Scope, ShouldResemble, checker.Scope{URIPrefix: "/economy"})
+				So(run.Checks, ShouldResemble, []string{checker.CheckURINames, checker.CheckSchemas})
+			})
+
+			Convey("And the same subtree is requested with other checks", func() {
+				first := decodeRun(w)
+				w2 := doRequest(a, http.MethodPost, "/checks", `{"uri_prefix": "/economy", "checks": ["uri-names"]}`)
+
+				Convey("Then a new run is queued", func() {
+					So(w2.Code, ShouldEqual, http.StatusAccepted)
+					So(decodeRun(w2).ID, ShouldNotEqual, first.ID)
+				})
+			})
+		})
+
+		Convey("When a check is requested with an unknown check", func() {
+			w := doRequest(a, http.MethodPost, "/checks", `{"checks": ["uri-names", "spelling"]}`)
+
+			Convey("Then a bad request status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(w.Body.String(), ShouldContainSubstring, "unknown check 'spelling'")
+			})
+		})
+
+		Convey("When a check is requested with no body", func() {
+			w := doRequest(a, http.MethodPost, "/checks", "")
+
+			Convey("Then an unscoped run is accepted", func() {
+				So(w.Code, ShouldEqual, http.StatusAccepted)
+				So(decodeRun(w).Scope.IsZero(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a check is requested with an invalid body", func() {
+			w := doRequest(a, http.MethodPost, "/checks", `{"collection": `)
+
+			Convey("Then a bad request status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+
+		Convey("When a check is requested with an invalid date", func() {
+			w := doRequest(a, http.MethodPost, "/checks", `{"from": "09/02/2023"}`)
+
+			Convey("Then a bad request status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(w.Body.String(), ShouldContainSubstring, "from must be a date in the format 2006-01-02")
+			})
+		})
+
+		Convey("When a check is requested with a date range ending before it starts", func() {
+			w := doRequest(a, http.MethodPost, "/checks", `{"from": "2023-02-09", "to": "2023-02-01"}`)
+
+			Convey("Then a bad request status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(w.Body.String(), ShouldContainSubstring, "to must not be before from")
+			})
+		})
+	})
+}
+
+func TestGetCheck(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given an API with a started runner over a valid but empty zebedee workspace", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "apitest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+		for _, dir := range []string{"zebedee/master", "zebedee/publish-log"} {
+			So(os.MkdirAll(path.Join(tempZebedeeRoot, dir), 0750), ShouldBeNil)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		runner := api.NewRunner(func() *checker.Checker { return &checker.Checker{ZebedeeRoot: tempZebedeeRoot} })
+		reports := make(chan history.Record, 1)
+		runner.Report = func(ctx context.Context, rec history.Record) { reports <- rec }
+		runner.Start(ctx)
+		a := &api.API{Runner: runner}
+
+		Convey("When a check is requested and later retrieved", func() {
+			queued := decodeRun(doRequest(a, http.MethodPost, "/checks", ""))
+
+			var run api.Run
+			for i := 0; i < 100; i++ {
+				w := doRequest(a, http.MethodGet, "/checks/"+queued.ID, "")
+				So(w.Code, ShouldEqual, http.StatusOK)
+				run = decodeRun(w)
+				if run.State == api.StateCompleted || run.State == api.StateFailed {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			Convey("Then the completed run holds the checker result", func() {
+				So(run.ID, ShouldEqual, queued.ID)
+				So(run.State, ShouldEqual, api.StateCompleted)
+				So(run.CompletedAt, ShouldNotBeNil)
+				So(run.Result, ShouldNotBeNil)
+				So(run.Result.Success, ShouldBeTrue)
+			})
+
+			Convey("Then the run is reported", func() {
+				select {
+				case rec := <-reports:
+					So(rec.RanAt, ShouldEqual, *run.StartedAt)
+					So(rec.Result, ShouldResemble, *run.Result)
+				case <-time.After(time.Second):
+					So("no report", ShouldBeEmpty)
+				}
+			})
+		})
+
+		Convey("When an unknown run is retrieved", func() {
+			w := doRequest(a, http.MethodGet, "/checks/unknown", "")
+
+			Convey("Then a not found status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func TestGetHistory(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given an API with a history store holding two days of runs", t, func() {
+		tempDir, err := os.MkdirTemp("", "apitest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		store := history.New(path.Join(tempDir, "history.db"))
+		ctx := context.Background()
+		So(store.Record(ctx, time.Date(2023, 2, 8, 6, 45, 0, 0, time.UTC),
+			&checker.Result{Success: false, Inconsistencies: []string{"inc1"}, Findings: []checker.Finding{
+				{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/a", Message: "inc1"},
+			}}), ShouldBeNil)
+		So(store.Record(ctx, time.Date(2023, 2, 9, 6, 45, 0, 0, time.UTC),
+			&checker.Result{Success: true}), ShouldBeNil)
+
+		a := &api.API{Runner: api.NewRunner(nil), History: store}
+
+		Convey("When the finding trends are requested", func() {
+			w := doRequest(a, http.MethodGet, "/history/findings", "")
+
+			Convey("Then the findings are returned with when they were seen", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				var trends []history.FindingTrend
+				So(json.Unmarshal(w.Body.Bytes(), &trends), ShouldBeNil)
+				So(trends, ShouldHaveLength, 1)
+				So(trends[0].Path, ShouldEqual, "/a")
+				So(trends[0].Ongoing, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the daily runs are requested for a single day", func() {
+			w := doRequest(a, http.MethodGet, "/history/runs?from=2023-02-09&to=2023-02-09", "")
+
+			Convey("Then only that day's runs are counted", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				var days []history.DailyRuns
+				So(json.Unmarshal(w.Body.Bytes(), &days), ShouldBeNil)
+				So(days, ShouldResemble, []history.DailyRuns{{Date: "2023-02-09", Runs: 1, Failures: 0}})
+			})
+		})
+
+		Convey("When the daily runs are requested with an invalid date", func() {
+			w := doRequest(a, http.MethodGet, "/history/runs?from=yesterday", "")
+
+			Convey("Then a bad request status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+	})
+
+	Convey("Given an API without a history store", t, func() {
+		a := &api.API{Runner: api.NewRunner(nil)}
+
+		Convey("When the finding trends are requested", func() {
+			w := doRequest(a, http.MethodGet, "/history/findings", "")
+
+			Convey("Then a not found status is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func doRequest(a *api.API, method, target, body string) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	w := httptest.NewRecorder()
+	a.Router().ServeHTTP(w, req)
+	return w
+}
+
+func decodeRun(w *httptest.ResponseRecorder) api.Run {
+	var run api.Run
+	So(json.Unmarshal(w.Body.Bytes(), &run), ShouldBeNil)
+	return run
+}
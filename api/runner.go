@@ -0,0 +1,200 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+// States of a queued checker run
+const (
+	StateQueued    = "queued"
+	StateRunning   = "running"
+	StateCompleted = "completed"
+	StateFailed    = "failed"
+)
+
+const (
+	queueSize = 20
+	maxRuns   = 100
+)
+
+// ErrQueueFull is returned when a run is submitted while the queue of pending runs is full
+var ErrQueueFull = errors.New("checker run queue is full")
+
+// Run holds the state and eventual result of an on-demand checker run
+type Run struct {
+	ID          string          `json:"id"`
+	Scope       checker.Scope   `json:"scope"`
+	Checks      []string        `json:"checks,omitempty"`
+	State       string          `json:"state"`
+	QueuedAt    time.Time       `json:"queued_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	Result      *checker.Result `json:"result,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+// Runner queues scoped checker runs and executes them one at a time, so that on-demand requests never walk the
+// zebedee workspace concurrently. A request for a scope and checks that are already queued or running is coalesced
+// into the existing run. If History is set, the results of unscoped runs of the configured checks are recorded in it. If Report is set, it is called
+// with the record of every completed run to write its reports.
+type Runner struct {
+	NewChecker func() *checker.Checker
+	History    *history.Store
+	Report     func(ctx context.Context, rec history.Record)
+
+	mu    sync.Mutex
+	runs  map[string]*Run
+	order []string
+	queue chan *Run
+}
+
+// NewRunner returns a Runner that creates a checker for each run using newChecker
+func NewRunner(newChecker func() *checker.Checker) *Runner {
+	return &Runner{
+		NewChecker: newChecker,
+		runs:       make(map[string]*Run),
+		queue:      make(chan *Run, queueSize),
+	}
+}
+
+// Start processes queued runs in the background until the context is cancelled
+func (r *Runner) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case run := <-r.queue:
+				r.execute(ctx, run)
+			}
+		}
+	}()
+}
+
+// Submit queues a run of the checks, or of the configured checks if none are given, for the scope, returning the
+// existing run if one with the same scope and checks is already pending
+func (r *Runner) Submit(ctx context.Context, scope checker.Scope, checks []string) (Run, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range r.order {
+		run := r.runs[id]
+		if run.Scope == scope && slices.Equal(run.Checks, checks) && (run.State == StateQueued || run.State == StateRunning) {
+			log.Info(ctx, "coalescing checker run request into existing run", log.Data{"run_id": run.ID})
+			return *run, nil
+		}
+	}
+
+	id, err := newRunID()
+	if err != nil {
+		return Run{}, err
+	}
+	run := &Run{
+		ID:       id,
+		Scope:    scope,
+		Checks:   checks,
+		State:    StateQueued,
+		QueuedAt: checker.Now(),
+	}
+
+	select {
+	case r.queue <- run:
+	default:
+		return Run{}, ErrQueueFull
+	}
+
+	r.runs[id] = run
+	r.order = append(r.order, id)
+	r.prune()
+
+	log.Info(ctx, "checker run queued", log.Data{"run_id": id, "scope": scope, "checks": checks})
+	return *run, nil
+}
+
+// Get returns a copy of the run with the given id
+func (r *Runner) Get(id string) (Run, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[id]
+	if !ok {
+		return Run{}, false
+	}
+	return *run, true
+}
+
+func (r *Runner) execute(ctx context.Context, run *Run) {
+	logData := log.Data{"run_id": run.ID, "scope": run.Scope, "checks": run.Checks}
+	log.Info(ctx, "starting checker run", logData)
+
+	r.mu.Lock()
+	started := checker.Now()
+	run.State = StateRunning
+	run.StartedAt = &started
+	r.mu.Unlock()
+
+	chk := r.NewChecker()
+	chk.Scope = run.Scope
+	if len(run.Checks) > 0 {
+		chk.Checks = run.Checks
+	}
+	result, err := chk.Run(ctx)
+
+	r.mu.Lock()
+	completed := checker.Now()
+	run.CompletedAt = &completed
+	if err != nil {
+		log.Error(ctx, "checker run failed", err, logData)
+		run.State = StateFailed
+		run.Error = err.Error()
+		r.mu.Unlock()
+		return
+	}
+	log.Info(ctx, "checker run complete", log.Data{"run_id": run.ID, "result": result})
+	run.State = StateCompleted
+	run.Result = result
+	r.mu.Unlock()
+
+	if r.Report != nil {
+		r.Report(ctx, history.Record{RanAt: started, Result: *result})
+	}
+
+	// scoped runs, and runs of other checks, only cover part of the workspace so would distort trends
+	if r.History != nil && run.Scope.IsZero() && len(run.Checks) == 0 {
+		if err := r.History.Record(ctx, started, result); err != nil {
+			log.Error(ctx, "unable to record checker run in history store", err, logData)
+		}
+	}
+}
+
+// prune drops the oldest finished runs once more than maxRuns are held. Must be called with the lock held.
+func (r *Runner) prune() {
+	for i := 0; len(r.order) > maxRuns && i < len(r.order); {
+		id := r.order[i]
+		if state := r.runs[id].State; state == StateQueued || state == StateRunning {
+			i++
+			continue
+		}
+		delete(r.runs, id)
+		r.order = append(r.order[:i], r.order[i+1:]...)
+	}
+}
+
+func newRunID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate run id")
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -7,30 +7,108 @@ import (
 	"github.com/pkg/errors"
 	"os"
 	"path"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 )
 
 const (
-	master      = "zebedee/master"
-	publish_log = "zebedee/publish-log"
+	master         = "zebedee/master"
+	publish_log    = "zebedee/publish-log"
+	collectionsDir = "zebedee/collections"
 )
 
+// check is an integrity check run against a zebedee workspace whose master and publish-log dirs exist. Checks report
+// inconsistencies through AddFinding, returning an error only if the check could not be completed. Master-wide checks
+// walk all of master rather than particular collections, so are only run when selected by name and are skipped in runs
+// scoped to a collection. Informational checks report findings with SeverityInfo and are only run when selected by
+// name.
+type check struct {
+	id            string
+	run           func(c *Checker, ctx context.Context) (bool, error)
+	masterWide    bool
+	informational bool
+}
+
+// checks are run in order by Run
+var checks = []check{
+	{id: CheckPublishedDirs, run: (*Checker).CheckPublishedCollections},
+	{id: CheckPendingDeletes, run: (*Checker).CheckPendingDeletes},
+	{id: CheckPreviousVersions, run: (*Checker).CheckPreviousVersions, masterWide: true},
+	{id: CheckDownloads, run: (*Checker).CheckDownloads, masterWide: true},
+	{id: CheckFigures, run: (*Checker).CheckFigures, masterWide: true},
+	{id: CheckEmptyArtefacts, run: (*Checker).CheckEmptyArtefacts, masterWide: true},
+	{id: CheckPermissions, run: (*Checker).CheckPermissions, masterWide: true},
+	{id: CheckURINames, run: (*Checker).CheckURINames, masterWide: true},
+	{id: CheckRedirects, run: (*Checker).CheckRedirects, masterWide: true},
+	{id: CheckTaxonomy, run: (*Checker).CheckTaxonomy, masterWide: true},
+	{id: CheckReleases, run: (*Checker).CheckReleases, masterWide: true},
+	{id: CheckSchemas, run: (*Checker).CheckSchemas, masterWide: true},
+	{id: CheckCollectionStates, run: (*Checker).CheckCollectionStates},
+	{id: CheckURIConflicts, run: (*Checker).CheckURIConflicts},
+	{id: CheckCollectionKeys, run: (*Checker).CheckCollectionKeys},
+	{id: CheckUserStores, run: (*Checker).CheckUserStores},
+	{id: CheckPublishDurations, run: (*Checker).CheckPublishDurations},
+	{id: CheckPublishLogNames, run: (*Checker).CheckPublishLogNames},
+	{id: CheckMasterChanges, run: (*Checker).CheckMasterChanges, masterWide: true},
+	{id: CheckStaleSessions, run: (*Checker).CheckStaleSessions, informational: true},
+}
+
 // Checker defines a runnable integrity checker
 type Checker struct {
 	ZebedeeRoot                string
 	CheckPublishedPreviousDays int
+	SuppressionsPath           string
+	EmptyAllowList             []string
+	ZebedeeUID                 int
+	ZebedeeGID                 int
+	SchemaVersion              string
+	SchemaDir                  string
+	KeyStoreDir                string
+	StaleAfter                 time.Duration
+	SlowPublishAfter           time.Duration
+	SlowPublishFactor          float64
+	Checks                     []string
+	Scope                      Scope
 	mu                         sync.Mutex
-	inconsistencies            []string
+	findings                   []Finding
+	suppressed                 []SuppressedFinding
 }
 
-// Result holds final results of an integrity checker run
+// Scope restricts a checker run to part of the zebedee workspace. The zero value checks every collection published
+// within CheckPublishedPreviousDays.
+type Scope struct {
+	Collection string    `json:"collection,omitempty"`
+	From       time.Time `json:"from,omitzero"`
+	To         time.Time `json:"to,omitzero"`
+	URIPrefix  string    `json:"uri_prefix,omitempty"`
+}
+
+// Result holds final results of an integrity checker run. Inconsistencies summarises the messages of the findings.
+// Findings accepted by a suppression are held separately and do not fail the run.
 type Result struct {
-	Success         bool
-	Inconsistencies []string
+	Success         bool                `json:"success"`
+	Inconsistencies []string            `json:"inconsistencies"`
+	Findings        []Finding           `json:"findings"`
+	Suppressed      []SuppressedFinding `json:"suppressed,omitempty"`
 }
 
 // Run runs the integrity checker
 func (c *Checker) Run(ctx context.Context) (*Result, error) {
+	selected, err := c.selectedChecks()
+	if err != nil {
+		return nil, err
+	}
+
+	var suppressions []Suppression
+	if c.SuppressionsPath != "" {
+		if suppressions, err = LoadSuppressions(c.SuppressionsPath); err != nil {
+			return nil, err
+		}
+		log.Info(ctx, "loaded suppressions", log.Data{"path": c.SuppressionsPath, "count": len(suppressions)})
+	}
+
 	validMaster, err := c.validateDir(ctx, master)
 	if err != nil {
 		return nil, err
@@ -41,19 +119,26 @@ func (c *Checker) Run(ctx context.Context) (*Result, error) {
 		return nil, err
 	}
 
-	valid := validMaster && validPublishLog
-
-	if valid {
-		validPublishedCols, err := c.CheckPublishedCollections(ctx)
-		if err != nil {
-			return nil, err
+	if validMaster && validPublishLog {
+		for _, chk := range selected {
+			if chk.masterWide && c.Scope.Collection != "" {
+				log.Info(ctx, "skipping master-wide check in run scoped to a collection", log.Data{"check": chk.id})
+				continue
+			}
+			if _, err := chk.run(c, ctx); err != nil {
+				return nil, errors.Wrapf(err, "error running check '%s'", chk.id)
+			}
 		}
-		valid = validPublishedCols && valid
 	}
 
+	// success is decided by the findings remaining once known inconsistencies are suppressed
+	c.applySuppressions(ctx, suppressions)
+
 	return &Result{
-		Success:         valid,
-		Inconsistencies: c.inconsistencies,
+		Success:         !c.hasInconsistencies(),
+		Inconsistencies: c.inconsistencyMessages(),
+		Findings:        c.findings,
+		Suppressed:      c.suppressed,
 	}, nil
 
 }
@@ -73,21 +158,85 @@ func (c *Checker) validateDir(ctx context.Context, dir string) (bool, error) {
 			return false, err
 		}
 		log.Info(ctx, "dir does not exist in zebedee root", logData)
-		c.AddInconsistency(fmt.Sprintf("'%s' dir missing from zebedee root", dir))
+		c.AddFinding(Finding{
+			Check:   CheckWorkspaceDirs,
+			Path:    dir,
+			Message: fmt.Sprintf("'%s' dir missing from zebedee root", dir),
+		})
 		return false, nil
 	}
 	log.Info(ctx, "dir exists in zebedee root", logData)
 	return true, nil
 }
 
+// AddFinding records an inconsistency found by a check
+func (c *Checker) AddFinding(f Finding) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.findings == nil {
+		c.findings = make([]Finding, 0)
+	}
+	c.findings = append(c.findings, f)
+}
+
+// AddInconsistency records an inconsistency that is described only by its message, as a finding of no particular
+// check.
+//
+// Deprecated: use AddFinding, which identifies the check, collection and path of the inconsistency.
 func (c *Checker) AddInconsistency(msg string) {
+	c.AddFinding(Finding{Message: msg})
+}
+
+// ValidateChecks returns an error if any of the ids is not that of a check
+func ValidateChecks(ids []string) error {
+	_, err := (&Checker{Checks: ids}).selectedChecks()
+	return err
+}
+
+// selectedChecks returns the checks named by Checks, in the order they are run, or every check other than the
+// master-wide and informational ones if none are named
+func (c *Checker) selectedChecks() ([]check, error) {
+	selected := make([]check, 0, len(checks))
+	for _, chk := range checks {
+		if len(c.Checks) == 0 && !chk.masterWide && !chk.informational || slices.Contains(c.Checks, chk.id) {
+			selected = append(selected, chk)
+		}
+	}
+	for _, id := range c.Checks {
+		if !slices.ContainsFunc(checks, func(chk check) bool { return chk.id == id }) {
+			return nil, errors.Errorf("unknown check '%s'", id)
+		}
+	}
+	return selected, nil
+}
+
+// hasInconsistencies reports whether any finding is an inconsistency rather than informational
+func (c *Checker) hasInconsistencies() bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.inconsistencies == nil {
-		c.inconsistencies = make([]string, 0)
+	return slices.ContainsFunc(c.findings, func(f Finding) bool { return f.Severity != SeverityInfo })
+}
+
+// inconsistencyMessages returns the distinct messages of the inconsistencies found, leaving out informational
+// findings, in the order they were first found
+func (c *Checker) inconsistencyMessages() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.findings == nil {
+		return nil
 	}
-	c.inconsistencies = append(c.inconsistencies, msg)
+	msgs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, f := range c.findings {
+		if f.Severity != SeverityInfo && !seen[f.Message] {
+			seen[f.Message] = true
+			msgs = append(msgs, f.Message)
+		}
+	}
+	return msgs
 }
 
 func (c *Checker) ensureZebedeeRoot() (string, error) {
@@ -97,3 +246,35 @@ func (c *Checker) ensureZebedeeRoot() (string, error) {
 	}
 	return root, nil
 }
+
+// IsZero reports whether the scope places no restriction on a run
+func (s Scope) IsZero() bool {
+	return s == Scope{}
+}
+
+// includesCollection reports whether a publish-log collection dir, e.g. '2023-02-09-12-13-collection2', is in scope.
+// The scope may name either the full dir or just the collection name following the date prefix.
+func (s Scope) includesCollection(dir string) bool {
+	if s.Collection == "" {
+		return true
+	}
+	return dir == s.Collection || strings.HasSuffix(dir, "-"+s.Collection)
+}
+
+// includesURI reports whether the uri falls within the scope's URI prefix, and whether the uri is an ancestor of the
+// prefix that must still be walked to reach it.
+func (s Scope) includesURI(uri string) (included, ancestor bool) {
+	prefix := s.uriPrefix()
+	if prefix == "" || uri == prefix || strings.HasPrefix(uri, prefix+"/") {
+		return true, false
+	}
+	return false, strings.HasPrefix(prefix, uri+"/")
+}
+
+func (s Scope) uriPrefix() string {
+	prefix := strings.TrimSuffix(s.URIPrefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	return prefix
+}
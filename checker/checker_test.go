@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"slices"
 	"testing"
 	"time"
 
@@ -134,11 +135,133 @@ func TestRun_MissingDirInMasterForPublishedCollection(t *testing.T) {
 				So(res.Success, ShouldBeFalse)
 				So(res.Inconsistencies, ShouldHaveLength, 1)
 				So(res.Inconsistencies[0], ShouldEqual, "dirs from collection '2023-02-09-12-13-collection2' missing from publishing master")
+				So(res.Findings, ShouldResemble, []checker.Finding{{
+					Check:      checker.CheckPublishedDirs,
+					Collection: "2023-02-09-12-13-collection2",
+					Path:       "/somepage/v2",
+					Message:    "dirs from collection '2023-02-09-12-13-collection2' missing from publishing master",
+				}})
 			})
 		})
 	})
 }
 
+func TestRun_MasterWideChecks(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a workspace with an inconsistency in master", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		addPages(tempZebedeeRoot, "zebedee/master/Economy")
+		addDirs(tempZebedeeRoot, "zebedee/publish-log")
+
+		Convey("When the checker is run without selecting checks", func() {
+			chk := checker.Checker{ZebedeeRoot: tempZebedeeRoot}
+			res, err := chk.Run(context.Background())
+			So(err, ShouldBeNil)
+
+			Convey("Then the master-wide checks are not run", func() {
+				So(res.Success, ShouldBeTrue)
+				So(res.Findings, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a master-wide check is run scoped to a collection", func() {
+			chk := checker.Checker{
+				ZebedeeRoot: tempZebedeeRoot,
+				Checks:      []string{checker.CheckURINames},
+				Scope:       checker.Scope{Collection: "collection1"},
+			}
+			res, err := chk.Run(context.Background())
+			So(err, ShouldBeNil)
+
+			Convey("Then the check is skipped", func() {
+				So(res.Success, ShouldBeTrue)
+				So(res.Findings, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestAddInconsistency(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a checker on a workspace missing dirs", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		chk := checker.Checker{ZebedeeRoot: tempZebedeeRoot}
+
+		Convey("When an inconsistency is added before the checker is run", func() {
+			chk.AddInconsistency("something inconsistent")
+			res, err := chk.Run(context.Background())
+			So(err, ShouldBeNil)
+
+			Convey("Then it is reported as a finding alongside those of the checks", func() {
+				So(res.Success, ShouldBeFalse)
+				So(res.Inconsistencies, ShouldResemble, []string{
+					"something inconsistent",
+					"'zebedee/master' dir missing from zebedee root",
+					"'zebedee/publish-log' dir missing from zebedee root",
+				})
+				So(res.Findings[0], ShouldResemble, checker.Finding{Message: "something inconsistent"})
+			})
+		})
+	})
+}
+
+// checkTest is a case of a single check run against a workspace
+type checkTest struct {
+	given     string                     // describes the workspace
+	setup     func(root string)          // adds content to the workspace
+	configure func(chk *checker.Checker) // sets further fields of the checker
+	findings  []checker.Finding          // the findings expected, in order
+}
+
+// runCheckTests runs each case through Run with only the check with the given id selected, so that it is unaffected
+// by other checks, asserting the findings and that the run fails on any that are not informational
+func runCheckTests(t *testing.T, id string, tests []checkTest) {
+	for _, tc := range tests {
+		Convey("Given "+tc.given, t, func() {
+			root := newZebedeeRoot()
+			if tc.setup != nil {
+				tc.setup(root)
+			}
+			chk := &checker.Checker{ZebedeeRoot: root, CheckPublishedPreviousDays: 1, Checks: []string{id}}
+			if tc.configure != nil {
+				tc.configure(chk)
+			}
+
+			Convey("When the "+id+" check is run", func() {
+				res, err := chk.Run(context.Background())
+				So(err, ShouldBeNil)
+
+				Convey("Then the expected findings are reported", func() {
+					So(res.Findings, ShouldResemble, tc.findings)
+					So(res.Success, ShouldEqual, !slices.ContainsFunc(tc.findings, func(f checker.Finding) bool {
+						return f.Severity != checker.SeverityInfo
+					}))
+				})
+			})
+		})
+	}
+}
+
+// newZebedeeRoot creates a temporary zebedee root with empty master and publish-log dirs, removed once the enclosing
+// Convey block completes
+func newZebedeeRoot() string {
+	root, err := os.MkdirTemp("", "checkertest")
+	So(err, ShouldBeNil)
+	Reset(func() { os.RemoveAll(root) })
+	addDirs(root, "zebedee/master", "zebedee/publish-log")
+	return root
+}
+
 func addDirs(ws string, dirs ...string) {
 	for _, dir := range dirs {
 		err := os.MkdirAll(path.Join(ws, dir), 0750)
@@ -146,6 +269,14 @@ func addDirs(ws string, dirs ...string) {
 	}
 }
 
+// addPages adds dirs each holding a data.json, so that they are not reported as empty
+func addPages(ws string, dirs ...string) {
+	for _, dir := range dirs {
+		err := addFile(ws, path.Join(dir, "data.json"), []byte("{}"))
+		So(err, ShouldBeNil)
+	}
+}
+
 func addFile(ws, fpath string, body []byte) error {
 	dir := path.Dir(fpath)
 	err := os.MkdirAll(path.Join(ws, dir), 0750)
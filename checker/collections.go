@@ -0,0 +1,173 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// collectionStates are the state dirs of a collection in the order content moves through them
+var collectionStates = []string{zebedee.StateInProgress, zebedee.StateComplete, zebedee.StateReviewed}
+
+// openCollection is a collection being edited under zebedee/collections, named by its json file and dir
+type openCollection struct {
+	name       string
+	collection *zebedee.Collection
+}
+
+// getOpenCollections returns every collection under zebedee/collections, ordered by name, leaving callers to apply the
+// collection scope. Collections whose json cannot be decoded are logged and skipped.
+func (c *Checker) getOpenCollections(ctx context.Context) ([]openCollection, error) {
+	root, err := c.ensureZebedeeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	filenames, err := filepath.Glob(path.Join(root, collectionsDir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unexpected error searching for collections")
+	}
+
+	collections := make([]openCollection, 0)
+	for _, filename := range filenames {
+		name := strings.TrimSuffix(path.Base(filename), ".json")
+		col, err := zebedee.GetCollectionFromFile(filename)
+		if err != nil {
+			if isDecodeError(err) {
+				log.Info(ctx, "skipping collection with undecodable json", log.Data{"collection": name, "error": err.Error()})
+				continue
+			}
+			return nil, err
+		}
+		collections = append(collections, openCollection{name: name, collection: col})
+	}
+
+	log.Info(ctx, "found collections", log.Data{"collection_count": len(collections)})
+	return collections, nil
+}
+
+// CheckCollectionStates checks that the inprogress, complete and reviewed dirs of each collection under
+// zebedee/collections agree with the uris listed for each state in its json. A uri should be in only one state, and
+// an approved collection should have all of its content reviewed.
+func (c *Checker) CheckCollectionStates(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking state dirs of collections")
+
+	collections, err := c.getOpenCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	valid := true
+	for _, col := range collections {
+		if !c.Scope.includesCollection(col.name) {
+			continue
+		}
+		colValid, err := c.checkCollectionStates(ctx, col)
+		if err != nil {
+			return false, err
+		}
+		valid = colValid && valid
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkCollectionStates(ctx context.Context, col openCollection) (bool, error) {
+	valid := true
+	addFinding := func(uri, message string) {
+		valid = false
+		c.AddFinding(Finding{Check: CheckCollectionStates, Collection: col.name, Path: uri, Message: message})
+	}
+
+	onDisk := make(map[string][]string)
+	statesOf := make(map[string][]string)
+	for _, state := range collectionStates {
+		files, err := c.collectionStateFiles(col.name, state)
+		if err != nil {
+			return false, err
+		}
+		onDisk[state] = files
+		for _, uri := range files {
+			statesOf[uri] = append(statesOf[uri], state)
+		}
+	}
+
+	for _, state := range collectionStates {
+		for _, uri := range onDisk[state] {
+			states := statesOf[uri]
+			if len(states) > 1 && states[0] == state {
+				addFinding(uri, fmt.Sprintf("uri in more than one state of collection '%s': %s", col.name, strings.Join(states, ", ")))
+			}
+		}
+
+		listed := col.collection.URIs(state)
+		for _, uri := range onDisk[state] {
+			if !slices.Contains(listed, uri) {
+				addFinding(uri, fmt.Sprintf("file in %s of collection '%s' not listed in its json", state, col.name))
+			}
+		}
+		for _, uri := range listed {
+			included, _ := c.Scope.includesURI(uri)
+			if included && !slices.Contains(onDisk[state], uri) {
+				addFinding(uri, fmt.Sprintf("item listed as %s in collection '%s' missing from its %s dir", state, col.name, state))
+			}
+		}
+	}
+
+	if col.collection.ApprovalStatus == zebedee.ApprovalComplete {
+		for _, state := range []string{zebedee.StateInProgress, zebedee.StateComplete} {
+			if len(onDisk[state]) > 0 || len(col.collection.URIs(state)) > 0 {
+				addFinding("", fmt.Sprintf("approved collection '%s' still has %s content", col.name, state))
+			}
+		}
+	}
+
+	if !valid {
+		log.Info(ctx, "collection state dirs inconsistent with its json", log.Data{"collection": col.name})
+	}
+	return valid, nil
+}
+
+// collectionStateFiles returns the uris of the files within the scope in a state dir of a collection, in lexical order
+func (c *Checker) collectionStateFiles(collection, state string) ([]string, error) {
+	stateDir := path.Join(c.ZebedeeRoot, collectionsDir, collection, state)
+	files := make([]string, 0)
+
+	err := filepath.WalkDir(stateDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == stateDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		uri := p[len(stateDir):]
+		if uri == "" {
+			return nil
+		}
+
+		included, ancestor := c.Scope.includesURI(uri)
+		if !included && !ancestor {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if included && !d.IsDir() {
+			files = append(files, uri)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error walking %s dir of collection '%s'", state, collection)
+	}
+	return files, nil
+}
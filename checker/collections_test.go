@@ -0,0 +1,88 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckCollectionStates(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckCollectionStates, []checkTest{
+		{
+			given: "collections whose state dirs agree with their json",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/collections/collection1.json", []byte(`{
+					"name": "Collection 1",
+					"approvalStatus": "NOT_STARTED",
+					"inProgressUris": ["/economy/a/data.json"],
+					"completeUris": ["/economy/b/data.json"],
+					"reviewedUris": ["/economy/c/data.json", "/economy/c/chart.json"]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/inprogress/economy/a/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/complete/economy/b/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/reviewed/economy/c/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/reviewed/economy/c/chart.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2.json", []byte(`{
+					"name": "Collection 2",
+					"publishDate": "Feb 9, 2023 9:30:00 AM",
+					"approvalStatus": "COMPLETE",
+					"reviewedUris": ["/economy/d/data.json"]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2/reviewed/economy/d/data.json", []byte("{}")), ShouldBeNil)
+			},
+		},
+		{
+			given: "collections whose state dirs disagree with their json",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/collections/collection1.json", []byte(`{
+					"name": "Collection 1",
+					"approvalStatus": "IN_PROGRESS",
+					"inProgressUris": ["/economy/a/data.json"],
+					"reviewedUris": ["/economy/a/data.json", "/economy/b/data.json"]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/inprogress/economy/a/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/reviewed/economy/a/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection1/reviewed/economy/c/data.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2.json", []byte(`{
+					"name": "Collection 2",
+					"approvalStatus": "COMPLETE",
+					"completeUris": ["/economy/d/data.json"]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2/complete/economy/d/data.json", []byte("{}")), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckCollectionStates,
+					Collection: "collection1",
+					Path:       "/economy/a/data.json",
+					Message:    "uri in more than one state of collection 'collection1': inprogress, reviewed",
+				},
+				{
+					Check:      checker.CheckCollectionStates,
+					Collection: "collection1",
+					Path:       "/economy/c/data.json",
+					Message:    "file in reviewed of collection 'collection1' not listed in its json",
+				},
+				{
+					Check:      checker.CheckCollectionStates,
+					Collection: "collection1",
+					Path:       "/economy/b/data.json",
+					Message:    "item listed as reviewed in collection 'collection1' missing from its reviewed dir",
+				},
+				{
+					Check:      checker.CheckCollectionStates,
+					Collection: "collection2",
+					Message:    "approved collection 'collection2' still has complete content",
+				},
+			},
+		},
+	})
+}
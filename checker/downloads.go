@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// pageTypesWithDownloads are the page types whose data.json lists downloadable files
+var pageTypesWithDownloads = map[string]bool{
+	"dataset":            true,
+	"timeseries_dataset": true,
+	"timeseries":         true,
+}
+
+// downloadExtensions are the extensions of files in a dataset page dir that are expected to be referenced as downloads
+var downloadExtensions = map[string]bool{
+	".csv":  true,
+	".xls":  true,
+	".xlsx": true,
+	".csdb": true,
+}
+
+// CheckDownloads checks that every download referenced by a dataset or timeseries page in master exists and is not
+// empty, and that every downloadable file in those page dirs is referenced by the page
+func (c *Checker) CheckDownloads(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking downloads of dataset and timeseries pages in master")
+
+	valid := true
+	err := c.walkMasterPages(ctx, func(uri string, page *zebedee.Page) error {
+		if !pageTypesWithDownloads[page.Type] {
+			return nil
+		}
+		pageValid, err := c.checkPageDownloads(ctx, uri, page)
+		if err != nil {
+			return err
+		}
+		valid = pageValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkPageDownloads(ctx context.Context, uri string, page *zebedee.Page) (bool, error) {
+	valid := true
+	addFinding := func(fileURI, msg string) {
+		valid = false
+		c.AddFinding(Finding{Check: CheckDownloads, Path: fileURI, Message: msg})
+	}
+
+	referenced := make(map[string]bool)
+	for _, d := range page.Downloads {
+		fileURI := downloadURI(uri, d)
+		if fileURI == "" {
+			continue
+		}
+		referenced[fileURI] = true
+
+		info, err := os.Stat(c.masterPath(fileURI))
+		switch {
+		case os.IsNotExist(err):
+			addFinding(fileURI, fmt.Sprintf("download referenced by page '%s' missing from master", uri))
+		case err != nil:
+			return false, err
+		case info.IsDir():
+			addFinding(fileURI, fmt.Sprintf("download referenced by page '%s' is a dir", uri))
+		case info.Size() == 0:
+			addFinding(fileURI, fmt.Sprintf("download referenced by page '%s' is empty", uri))
+		}
+	}
+
+	entries, err := os.ReadDir(c.masterPath(uri))
+	if err != nil {
+		return false, err
+	}
+	for _, e := range entries {
+		fileURI := path.Join(uri, e.Name())
+		if e.IsDir() || !downloadExtensions[strings.ToLower(path.Ext(e.Name()))] || referenced[fileURI] {
+			continue
+		}
+		addFinding(fileURI, fmt.Sprintf("download file not referenced by page '%s'", uri))
+	}
+
+	if !valid {
+		log.Info(ctx, "inconsistent downloads of page", log.Data{"uri": uri})
+	}
+	return valid, nil
+}
+
+// downloadURI resolves the uri of a download, which may be given as a file relative to the page or as a uri
+func downloadURI(pageURI string, d zebedee.Download) string {
+	ref := d.File
+	if ref == "" {
+		ref = d.URI
+	}
+	if ref == "" {
+		return ""
+	}
+	return resolveURI(pageURI, ref)
+}
@@ -0,0 +1,60 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckDownloads(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckDownloads, []checkTest{
+		{
+			given: "a dataset page with consistent downloads",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/datasets/mm23/current/data.json", []byte(`{"type": "dataset", "downloads": [
+					{"title": "CSV", "file": "mm23.csv"}, {"title": "CSDB", "uri": "/datasets/mm23/current/mm23.csdb"}
+				]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/datasets/mm23/current/mm23.csv", []byte("a,b")), ShouldBeNil)
+				So(addFile(root, "zebedee/master/datasets/mm23/current/mm23.csdb", []byte("ab")), ShouldBeNil)
+			},
+		},
+		{
+			given: "dataset pages with inconsistent downloads",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/datasets/mm23/current/data.json", []byte(`{"type": "dataset", "downloads": [
+					{"title": "CSV", "file": "mm23.csv"}, {"title": "XLSX", "file": "mm23.xlsx"}
+				]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/datasets/mm23/current/mm23.xlsx", []byte{}), ShouldBeNil)
+				So(addFile(root, "zebedee/master/datasets/mm23/current/old.CSV", []byte("a,b")), ShouldBeNil)
+				// files beside pages that are not datasets are not checked
+				So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{"type": "bulletin"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/table.xls", []byte("ab")), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckDownloads,
+					Path:    "/datasets/mm23/current/mm23.csv",
+					Message: "download referenced by page '/datasets/mm23/current' missing from master",
+				},
+				{
+					Check:   checker.CheckDownloads,
+					Path:    "/datasets/mm23/current/mm23.xlsx",
+					Message: "download referenced by page '/datasets/mm23/current' is empty",
+				},
+				{
+					Check:   checker.CheckDownloads,
+					Path:    "/datasets/mm23/current/old.CSV",
+					Message: "download file not referenced by page '/datasets/mm23/current'",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// CheckEmptyArtefacts checks for empty dirs and zero-byte files, including data.json, in master and in the collections
+// published within the window, and for page dirs in master with no data.json. Only dirs that look like pages, holding
+// previous versions or json assets such as figures, need a data.json, as master also holds dirs of other files such
+// as images. Paths matching a glob in EmptyAllowList are not reported.
+func (c *Checker) CheckEmptyArtefacts(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking for empty artefacts in master")
+
+	valid := true
+	tracker := &emptyTracker{
+		requireData: true,
+		report: func(uri, what string) {
+			if c.emptyAllowed(uri) {
+				return
+			}
+			valid = false
+			c.AddFinding(Finding{Check: CheckEmptyArtefacts, Path: uri, Message: what + " in master"})
+		},
+	}
+	if err := c.walkMaster(ctx, tracker.visit); err != nil {
+		return false, err
+	}
+	tracker.finish()
+
+	log.Info(ctx, "checking for empty artefacts in published collections")
+	collections, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, collection := range collections {
+		tracker := &emptyTracker{
+			report: func(uri, what string) {
+				if c.emptyAllowed(uri) {
+					return
+				}
+				valid = false
+				c.AddFinding(Finding{
+					Check:      CheckEmptyArtefacts,
+					Collection: collection,
+					Path:       uri,
+					Message:    fmt.Sprintf("%s in published collection '%s'", what, collection),
+				})
+			},
+		}
+		if err := c.walkPublishedCollection(ctx, collection, tracker.visit); err != nil {
+			return false, err
+		}
+		tracker.finish()
+	}
+
+	if !valid {
+		log.Info(ctx, "empty artefacts found")
+	}
+	return valid, nil
+}
+
+func (c *Checker) emptyAllowed(uri string) bool {
+	for _, glob := range c.EmptyAllowList {
+		if matchPath(glob, uri) {
+			return true
+		}
+	}
+	return false
+}
+
+// emptyTracker finds empty artefacts in a single depth-first walk, holding the stats of the dirs on the current branch
+// and reporting each dir once the walk has moved past it
+type emptyTracker struct {
+	requireData bool
+	report      func(uri, what string)
+	stack       []*dirStats
+}
+
+type dirStats struct {
+	uri         string
+	entries     int
+	hasData     bool
+	hasPrevious bool
+	hasJSON     bool
+}
+
+func (t *emptyTracker) visit(uri string, d fs.DirEntry) error {
+	parent := path.Dir(uri)
+	for len(t.stack) > 0 && t.stack[len(t.stack)-1].uri != parent {
+		t.pop()
+	}
+	if len(t.stack) > 0 {
+		top := t.stack[len(t.stack)-1]
+		top.entries++
+		switch {
+		case d.IsDir():
+			top.hasPrevious = top.hasPrevious || d.Name() == previousDir
+		case d.Name() == dataJSON:
+			top.hasData = true
+		case path.Ext(d.Name()) == ".json":
+			top.hasJSON = true
+		}
+	}
+
+	if d.IsDir() {
+		t.stack = append(t.stack, &dirStats{uri: uri})
+		return nil
+	}
+
+	info, err := d.Info()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // removed since the dir was read
+		}
+		return err
+	}
+	if info.Mode().IsRegular() && info.Size() == 0 {
+		if d.Name() == dataJSON {
+			t.report(uri, "empty data.json")
+		} else {
+			t.report(uri, "zero-byte file")
+		}
+	}
+	return nil
+}
+
+// finish reports the dirs remaining on the stack once the walk is complete
+func (t *emptyTracker) finish() {
+	for len(t.stack) > 0 {
+		t.pop()
+	}
+}
+
+func (t *emptyTracker) pop() {
+	dir := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	switch {
+	case dir.entries == 0:
+		t.report(dir.uri, "empty dir")
+	case t.requireData && !dir.hasData && (dir.hasPrevious || dir.hasJSON):
+		t.report(dir.uri, "page dir with no data.json")
+	}
+}
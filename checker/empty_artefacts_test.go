@@ -0,0 +1,81 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckEmptyArtefacts(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	}
+
+	runCheckTests(t, checker.CheckEmptyArtefacts, []checkTest{
+		{
+			given: "a workspace with pages and dirs of other files",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/page1",
+					"zebedee/master/economy/page1/previous/v1",
+					"zebedee/publish-log/2023-02-09-10-13-collection1/economy/page1",
+				)
+				So(addFile(root, "zebedee/master/economy/page1/chart.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/master/visualisations/dvc1/index.html", []byte("<html>")), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-13-collection1.json", []byte("{}")), ShouldBeNil)
+			},
+		},
+		{
+			given: "a workspace with empty artefacts in master and a published collection",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/page1",
+					"zebedee/master/economy/page2/previous/v1",
+					"zebedee/publish-log/2023-02-09-10-13-collection1/economy/page1",
+				)
+				addDirs(root,
+					"zebedee/master/economy/emptydir",
+					"zebedee/master/images/allowed",
+					"zebedee/publish-log/2023-02-09-10-13-collection1/economy/emptydir",
+				)
+				So(addFile(root, "zebedee/master/economy/page2/data.json", []byte{}), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/page1/chart.json", []byte{}), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/nodata/table.json", []byte("{}")), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/nodata/table.xls", []byte("xls")), ShouldBeNil)
+				addPages(root, "zebedee/master/economy/unversioned/previous/v1")
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-13-collection1/economy/page1/file.csv", []byte{}), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-13-collection1.json", []byte("{}")), ShouldBeNil)
+			},
+			configure: func(chk *checker.Checker) { chk.EmptyAllowList = []string{"/images/**"} },
+			findings: []checker.Finding{
+				{Check: checker.CheckEmptyArtefacts, Path: "/economy/emptydir", Message: "empty dir in master"},
+				{Check: checker.CheckEmptyArtefacts, Path: "/economy/nodata", Message: "page dir with no data.json in master"},
+				{Check: checker.CheckEmptyArtefacts, Path: "/economy/page1/chart.json", Message: "zero-byte file in master"},
+				{Check: checker.CheckEmptyArtefacts, Path: "/economy/page2/data.json", Message: "empty data.json in master"},
+				{Check: checker.CheckEmptyArtefacts, Path: "/economy/unversioned", Message: "page dir with no data.json in master"},
+				{
+					Check:      checker.CheckEmptyArtefacts,
+					Collection: "2023-02-09-10-13-collection1",
+					Path:       "/economy/emptydir",
+					Message:    "empty dir in published collection '2023-02-09-10-13-collection1'",
+				},
+				{
+					Check:      checker.CheckEmptyArtefacts,
+					Collection: "2023-02-09-10-13-collection1",
+					Path:       "/economy/page1/file.csv",
+					Message:    "zero-byte file in published collection '2023-02-09-10-13-collection1'",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// pageTypesWithFigures are the page types whose data.json may reference charts, tables and images
+var pageTypesWithFigures = map[string]bool{
+	"bulletin":         true,
+	"article":          true,
+	"article_download": true,
+}
+
+// figureMarkup matches figures embedded in section markdown, e.g. <ons-chart path="/a/b/c/1a2b3c4d" />
+var figureMarkup = regexp.MustCompile(`<ons-(chart|table|image)\s+path="([^"]+)"`)
+
+// figureAssets are the extensions of the assets zebedee writes beside a page for each kind of figure. Images also have
+// the files listed in their json.
+var figureAssets = map[string][]string{
+	"chart": {".json"},
+	"table": {".json", ".xls"},
+	"image": {".json"},
+}
+
+// figureRef is a figure referenced by a page, along with where the reference was found
+type figureRef struct {
+	kind   string
+	uri    string
+	source string
+}
+
+// CheckFigures checks that the assets of every chart, table and image referenced by a bulletin or article in master
+// exist, whether referenced from the page's figure lists or embedded in its section markdown. These are the json of
+// each figure, the xls of tables and the files listed in the json of images, such as their png.
+func (c *Checker) CheckFigures(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking figures of bulletins and articles in master")
+
+	valid := true
+	err := c.walkMasterPages(ctx, func(uri string, page *zebedee.Page) error {
+		if !pageTypesWithFigures[page.Type] {
+			return nil
+		}
+		pageValid, err := c.checkPageFigures(ctx, uri, page)
+		if err != nil {
+			return err
+		}
+		valid = pageValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkPageFigures(ctx context.Context, uri string, page *zebedee.Page) (bool, error) {
+	valid := true
+	checked := make(map[string]bool)
+
+	checkAsset := func(ref figureRef, assetURI string) error {
+		if checked[assetURI] {
+			return nil
+		}
+		checked[assetURI] = true
+
+		exists, _, err := c.existsInMaster(assetURI)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			valid = false
+			c.AddFinding(Finding{
+				Check:   CheckFigures,
+				Path:    assetURI,
+				Message: fmt.Sprintf("%s referenced in %s of page '%s' missing from master", ref.kind, ref.source, uri),
+			})
+		}
+		return nil
+	}
+
+	images := make(map[string]bool)
+	for _, ref := range pageFigures(uri, page) {
+		for _, ext := range figureAssets[ref.kind] {
+			if err := checkAsset(ref, ref.uri+ext); err != nil {
+				return false, err
+			}
+		}
+		if ref.kind != "image" || images[ref.uri] {
+			continue
+		}
+		images[ref.uri] = true
+
+		files, err := c.imageFiles(ctx, ref.uri)
+		if err != nil {
+			return false, err
+		}
+		for _, f := range files {
+			if err := checkAsset(ref, f); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if !valid {
+		log.Info(ctx, "page references missing figures", log.Data{"uri": uri})
+	}
+	return valid, nil
+}
+
+// imageFiles returns the uris of the files listed in the json of an image, or none if the json is missing or cannot
+// be decoded
+func (c *Checker) imageFiles(ctx context.Context, imageURI string) ([]string, error) {
+	image, err := zebedee.GetImageFromFile(c.masterPath(imageURI + ".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		if isDecodeError(err) {
+			log.Info(ctx, "skipping files of image with undecodable json", log.Data{"uri": imageURI, "error": err.Error()})
+			return nil, nil
+		}
+		return nil, err
+	}
+	files := make([]string, 0, len(image.Files))
+	for _, f := range image.Files {
+		if f.Filename != "" {
+			files = append(files, path.Join(path.Dir(imageURI), f.Filename))
+		}
+	}
+	return files, nil
+}
+
+// pageFigures returns the figures referenced by the page's chart, table and image lists and its section markdown
+func pageFigures(uri string, page *zebedee.Page) []figureRef {
+	refs := make([]figureRef, 0)
+
+	for _, list := range []struct {
+		kind    string
+		figures []zebedee.Figure
+	}{
+		{"chart", page.Charts},
+		{"table", page.Tables},
+		{"image", page.Images},
+	} {
+		for _, f := range list.figures {
+			ref := f.URI
+			if ref == "" {
+				ref = f.Filename
+			}
+			if ref != "" {
+				refs = append(refs, figureRef{kind: list.kind, uri: resolveURI(uri, ref), source: list.kind + "s"})
+			}
+		}
+	}
+
+	for _, sections := range []struct {
+		source   string
+		sections []zebedee.Section
+	}{
+		{"sections", page.Sections},
+		{"accordion", page.Accordion},
+	} {
+		for _, s := range sections.sections {
+			for _, m := range figureMarkup.FindAllStringSubmatch(s.Markdown, -1) {
+				refs = append(refs, figureRef{kind: m[1], uri: resolveURI(uri, m[2]), source: sections.source})
+			}
+		}
+	}
+	return refs
+}
+
+// resolveURI resolves a reference from a page, which is either absolute or relative to the page
+func resolveURI(pageURI, ref string) string {
+	if strings.HasPrefix(ref, "/") {
+		return path.Clean(ref)
+	}
+	return path.Join(pageURI, ref)
+}
@@ -0,0 +1,72 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckFigures(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckFigures, []checkTest{
+		{
+			given: "a bulletin with all of its figure assets",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{
+					"type": "bulletin",
+					"sections": [{"title": "Main points", "markdown": "text <ons-chart path=\"/bulletins/b1/abc\" /> text"}],
+					"charts": [{"title": "Chart", "filename": "abc", "uri": "/bulletins/b1/abc"}],
+					"tables": [{"title": "Table", "filename": "def"}],
+					"images": [{"title": "Image", "filename": "ghi", "uri": "/bulletins/b1/ghi"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/abc.json", []byte(`{}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/def.json", []byte(`{}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/def.xls", []byte("xls")), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/ghi.json", []byte(`{"files": [{"type": "uploaded-image", "filename": "ghi.png"}]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/ghi.png", []byte("png")), ShouldBeNil)
+			},
+		},
+		{
+			given: "an article referencing missing figure assets",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/articles/a1/data.json", []byte(`{
+					"type": "article",
+					"sections": [{"markdown": "<ons-table path=\"ghi\" /> and <ons-chart path=\"/articles/a1/abc\" />"}],
+					"accordion": [{"markdown": "<ons-image path=\"/articles/a1/jkl\" />"}],
+					"charts": [{"filename": "abc", "uri": "/articles/a1/abc"}],
+					"images": [{"filename": "jkl", "uri": "/articles/a1/jkl"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/articles/a1/jkl.json", []byte(`{"files": [{"type": "uploaded-image", "filename": "jkl.png"}]}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckFigures,
+					Path:    "/articles/a1/abc.json",
+					Message: "chart referenced in charts of page '/articles/a1' missing from master",
+				},
+				{
+					Check:   checker.CheckFigures,
+					Path:    "/articles/a1/jkl.png",
+					Message: "image referenced in images of page '/articles/a1' missing from master",
+				},
+				{
+					Check:   checker.CheckFigures,
+					Path:    "/articles/a1/ghi.json",
+					Message: "table referenced in sections of page '/articles/a1' missing from master",
+				},
+				{
+					Check:   checker.CheckFigures,
+					Path:    "/articles/a1/ghi.xls",
+					Message: "table referenced in sections of page '/articles/a1' missing from master",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package checker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// IDs of the checks that report findings
+const (
+	CheckWorkspaceDirs    = "workspace-dirs"
+	CheckSuppressions     = "suppressions"
+	CheckPublishedDirs    = "published-dirs"
+	CheckPreviousVersions = "previous-versions"
+	CheckDownloads        = "downloads"
+	CheckFigures          = "figures"
+	CheckEmptyArtefacts   = "empty-artefacts"
+	CheckPermissions      = "permissions"
+	CheckURINames         = "uri-names"
+	CheckRedirects        = "redirects"
+	CheckTaxonomy         = "taxonomy"
+	CheckReleases         = "releases"
+	CheckSchemas          = "schemas"
+	CheckCollectionStates = "collection-states"
+	CheckURIConflicts     = "uri-conflicts"
+	CheckPendingDeletes   = "pending-deletes"
+	CheckCollectionKeys   = "collection-keys"
+	CheckUserStores       = "user-stores"
+	CheckStaleSessions    = "stale-sessions"
+	CheckPublishDurations = "publish-durations"
+	CheckPublishLogNames  = "publish-log-names"
+	CheckMasterChanges    = "master-changes"
+)
+
+// SeverityInfo marks a finding as informational, reported without failing the run. Findings without a severity are
+// inconsistencies that fail it.
+const SeverityInfo = "info"
+
+// Finding is a single inconsistency reported by a check
+type Finding struct {
+	Check      string `json:"check"`
+	Collection string `json:"collection,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Message    string `json:"message"`
+	Severity   string `json:"severity,omitempty"`
+}
+
+// Fingerprint identifies a finding across runs from its check, collection and path, so that changes to the wording of
+// the message do not affect matching. Checks that report more than one rule at a path qualify the path with the rule
+// using rulePath, so that each has its own fingerprint.
+func (f Finding) Fingerprint() string {
+	h := sha256.New()
+	for _, s := range []string{f.Check, f.Collection, f.Path} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// rulePath qualifies the path of a finding with the rule it breaks, e.g. '/economy/data.json#world-writable'. The path
+// is empty for findings about the json of a collection as a whole.
+func rulePath(p, rule string) string {
+	return p + "#" + rule
+}
@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestFingerprint(t *testing.T) {
+	Convey("Given a finding", t, func() {
+		f := checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/somepage", Message: "msg"}
+
+		Convey("Then its fingerprint is unaffected by the message", func() {
+			reworded := f
+			reworded.Message = "another message"
+			So(reworded.Fingerprint(), ShouldEqual, f.Fingerprint())
+		})
+
+		Convey("Then its fingerprint differs from findings with a different check, collection or path", func() {
+			So(checker.Finding{Check: checker.CheckWorkspaceDirs, Collection: "col1", Path: "/somepage"}.Fingerprint(),
+				ShouldNotEqual, f.Fingerprint())
+			So(checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col2", Path: "/somepage"}.Fingerprint(),
+				ShouldNotEqual, f.Fingerprint())
+			So(checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/otherpage"}.Fingerprint(),
+				ShouldNotEqual, f.Fingerprint())
+		})
+
+		Convey("Then fields cannot run into each other to give the same fingerprint", func() {
+			So(checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1/", Path: "somepage"}.Fingerprint(),
+				ShouldNotEqual, f.Fingerprint())
+		})
+	})
+}
@@ -0,0 +1,182 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+// keyringDir is the key store of the collection keys within the zebedee root, used unless KeyStoreDir is set
+const keyringDir = "zebedee/keyring"
+
+// CheckCollectionKeys checks that the key store holds a non-empty key, named by the collection id, for every
+// collection under zebedee/collections, and that every key belongs to a collection. A missing key store is reported
+// once rather than for each collection, and keys that may belong to a collection with undecodable json are not
+// reported. Keys are only ever stat'ed and their contents are never read.
+func (c *Checker) CheckCollectionKeys(ctx context.Context) (bool, error) {
+	keyStore := c.KeyStoreDir
+	if keyStore == "" {
+		keyStore = path.Join(c.ZebedeeRoot, keyringDir)
+	}
+	log.Info(ctx, "checking collection keys in key store", log.Data{"key_store": keyStore})
+
+	keys, err := keyStoreKeys(keyStore)
+	if err != nil {
+		return false, err
+	}
+	collections, err := c.getOpenCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if keys == nil {
+		inScope := slices.ContainsFunc(collections, func(col openCollection) bool {
+			return c.Scope.includesCollection(col.name)
+		})
+		if !inScope {
+			return true, nil
+		}
+		c.AddFinding(Finding{
+			Check:      CheckCollectionKeys,
+			Collection: c.Scope.Collection,
+			Message:    "key store missing so no collection has a key",
+		})
+		return false, nil
+	}
+
+	valid := true
+	ids := make(map[string]bool)
+	for _, col := range collections {
+		id := col.collection.ID
+		if id == "" {
+			log.Info(ctx, "skipping collection with no id to find its key by", log.Data{"collection": col.name})
+			continue
+		}
+		ids[id] = true
+		if !c.Scope.includesCollection(col.name) {
+			continue
+		}
+
+		key, ok := keys[id]
+		switch {
+		case !ok:
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckCollectionKeys,
+				Collection: col.name,
+				Message:    fmt.Sprintf("no key in key store for collection '%s'", col.name),
+			})
+		case key.size == 0:
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckCollectionKeys,
+				Collection: col.name,
+				Path:       key.name,
+				Message:    fmt.Sprintf("key of collection '%s' is empty", col.name),
+			})
+		}
+	}
+
+	// a collection scope leaves the other collections unchecked, so keys can only be matched to them in a full run
+	if c.Scope.Collection == "" {
+		undecodable, err := c.undecodableCollections(ctx, collections)
+		if err != nil {
+			return false, err
+		}
+		for _, id := range slices.Sorted(maps.Keys(keys)) {
+			if key := keys[id]; !ids[id] && !mayBelongTo(id, undecodable) {
+				valid = false
+				c.AddFinding(Finding{
+					Check:   CheckCollectionKeys,
+					Path:    key.name,
+					Message: fmt.Sprintf("key '%s' in key store has no collection", key.name),
+				})
+			}
+		}
+	}
+
+	if !valid {
+		log.Info(ctx, "collection keys inconsistent with collections", log.Data{"key_store": keyStore})
+	}
+	return valid, nil
+}
+
+// storedKey is the metadata of a key file in the key store
+type storedKey struct {
+	name string
+	size int64
+}
+
+// keyStoreKeys returns the metadata of the key files in the key store, keyed by the collection id they are named by,
+// or nil if there is no key store
+func keyStoreKeys(keyStore string) (map[string]storedKey, error) {
+	entries, err := os.ReadDir(keyStore)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to read key store")
+	}
+
+	keys := make(map[string]storedKey)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to stat key in key store")
+		}
+		id := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		keys[id] = storedKey{name: e.Name(), size: info.Size()}
+	}
+	return keys, nil
+}
+
+// undecodableCollections returns the collections under zebedee/collections skipped for having undecodable json, keyed
+// by name, along with their id if it can still be read
+func (c *Checker) undecodableCollections(ctx context.Context, decoded []openCollection) (map[string]string, error) {
+	filenames, err := filepath.Glob(path.Join(c.ZebedeeRoot, collectionsDir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unexpected error searching for collections")
+	}
+
+	undecodable := make(map[string]string)
+	for _, filename := range filenames {
+		name := strings.TrimSuffix(path.Base(filename), ".json")
+		if slices.ContainsFunc(decoded, func(col openCollection) bool { return col.name == name }) {
+			continue
+		}
+		body, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		var col struct {
+			ID string `json:"id"`
+		}
+		_ = json.Unmarshal(body, &col)
+		log.Info(ctx, "not reporting keys of collection with undecodable json", log.Data{"collection": name, "id": col.ID})
+		undecodable[name] = col.ID
+	}
+	return undecodable, nil
+}
+
+// mayBelongTo returns whether the key with the id may belong to one of the undecodable collections, either by their
+// id or, where that could not be read, by being named after the collection as zebedee names its ids
+func mayBelongTo(id string, undecodable map[string]string) bool {
+	for name, colID := range undecodable {
+		if id == colID || (colID == "" && strings.HasPrefix(id, name+"-")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckCollectionKeys(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	addKeyProblems := func(root string) {
+		So(addFile(root, "zebedee/collections/collection1.json", []byte(`{"id": "collection1-abc"}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/collections/collection2.json", []byte(`{"id": "collection2-def"}`)), ShouldBeNil)
+		So(addFile(root, "keys/collection2-def.txt", []byte{}), ShouldBeNil)
+		So(addFile(root, "keys/collection3-ghi.txt", []byte("secret")), ShouldBeNil)
+	}
+	keyStore := func(chk *checker.Checker) {
+		chk.KeyStoreDir = path.Join(chk.ZebedeeRoot, "keys")
+	}
+
+	runCheckTests(t, checker.CheckCollectionKeys, []checkTest{
+		{
+			given: "a key for every collection",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/collections/collection1.json", []byte(`{"id": "collection1-abc"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2.json", []byte(`{"id": "collection2-def"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/keyring/collection1-abc.txt", []byte("secret")), ShouldBeNil)
+				So(addFile(root, "zebedee/keyring/collection2-def.txt", []byte("secret")), ShouldBeNil)
+			},
+		},
+		{
+			given:     "missing, empty and orphaned keys in a configured key store",
+			setup:     addKeyProblems,
+			configure: keyStore,
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckCollectionKeys,
+					Collection: "collection1",
+					Message:    "no key in key store for collection 'collection1'",
+				},
+				{
+					Check:      checker.CheckCollectionKeys,
+					Collection: "collection2",
+					Path:       "collection2-def.txt",
+					Message:    "key of collection 'collection2' is empty",
+				},
+				{
+					Check:   checker.CheckCollectionKeys,
+					Path:    "collection3-ghi.txt",
+					Message: "key 'collection3-ghi.txt' in key store has no collection",
+				},
+			},
+		},
+		{
+			given: "missing, empty and orphaned keys in a configured key store, scoped to a collection",
+			setup: addKeyProblems,
+			configure: func(chk *checker.Checker) {
+				keyStore(chk)
+				chk.Scope = checker.Scope{Collection: "collection2"}
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckCollectionKeys,
+					Collection: "collection2",
+					Path:       "collection2-def.txt",
+					Message:    "key of collection 'collection2' is empty",
+				},
+			},
+		},
+		{
+			given: "collections without a key store",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/collections/collection1.json", []byte(`{"id": "collection1-abc"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2.json", []byte(`{"id": "collection2-def"}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{Check: checker.CheckCollectionKeys, Message: "key store missing so no collection has a key"},
+			},
+		},
+		{
+			given: "no collections and no key store",
+		},
+		{
+			given: "keys of collections with undecodable json",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/collections/collection1.json", []byte(`{"id": "collection1-abc", "inProgressUris": {}}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/collections/collection2.json", []byte(`{"id": "collection2-def",`)), ShouldBeNil)
+				So(addFile(root, "zebedee/keyring/collection1-abc.txt", []byte("secret")), ShouldBeNil)
+				So(addFile(root, "zebedee/keyring/collection2-def.txt", []byte("secret")), ShouldBeNil)
+				So(addFile(root, "zebedee/keyring/collection3-ghi.txt", []byte("secret")), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckCollectionKeys,
+					Path:    "collection3-ghi.txt",
+					Message: "key 'collection3-ghi.txt' in key store has no collection",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+const (
+	dataJSON    = "data.json"
+	previousDir = "previous"
+)
+
+// walkMaster calls fn with the uri of every dir and file within the scope in master, walking down through ancestors
+// of the scoped uri without calling fn for them. As with filepath.WalkDir, fn may return filepath.SkipDir.
+func (c *Checker) walkMaster(ctx context.Context, fn func(uri string, d fs.DirEntry) error) error {
+	root, err := c.ensureZebedeeRoot()
+	if err != nil {
+		return err
+	}
+	masterDir := path.Join(root, master)
+
+	err = filepath.WalkDir(masterDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		uri := p[len(masterDir):]
+		if uri == "" {
+			return nil
+		}
+
+		included, ancestor := c.Scope.includesURI(uri)
+		if ancestor {
+			return nil
+		}
+		if !included {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(uri, d)
+	})
+	if err != nil {
+		return errors.Wrap(err, "error walking master dir tree")
+	}
+	return nil
+}
+
+// walkMasterPages calls fn with the uri and content of every page within the scope in master. Superseded versions
+// under 'previous' dirs are not walked, and pages whose data.json cannot be decoded are logged and skipped.
+func (c *Checker) walkMasterPages(ctx context.Context, fn func(uri string, page *zebedee.Page) error) error {
+	return c.walkMaster(ctx, func(uri string, d fs.DirEntry) error {
+		if d.IsDir() {
+			if d.Name() == previousDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() != dataJSON {
+			return nil
+		}
+
+		pageURI := path.Dir(uri)
+		page, err := zebedee.GetPageFromFile(c.masterPath(uri))
+		if err != nil {
+			if isDecodeError(err) {
+				log.Info(ctx, "skipping page with undecodable data.json", log.Data{"uri": pageURI, "error": err.Error()})
+				return nil
+			}
+			return err
+		}
+		return fn(pageURI, page)
+	})
+}
+
+// masterPath returns the path of the uri in master
+func (c *Checker) masterPath(uri string) string {
+	return path.Join(c.ZebedeeRoot, master, uri)
+}
+
+// existsInMaster reports whether the uri exists in master and, if so, whether it is a dir
+func (c *Checker) existsInMaster(uri string) (exists, isDir bool, err error) {
+	info, err := os.Stat(c.masterPath(uri))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+	return true, info.IsDir(), nil
+}
+
+func isDecodeError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
@@ -0,0 +1,198 @@
+package checker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// changeGroupGap is the longest gap between the modification times of files in the same dir for them to be reported
+// as one change
+const changeGroupGap = 10 * time.Minute
+
+// publishWriteTolerance is how long after the end of the publish that wrote it a file in master may have been
+// modified, allowing for the end date being recorded just before the last write
+const publishWriteTolerance = time.Minute
+
+// masterChange is a file in master modified within the window
+type masterChange struct {
+	uri     string
+	modTime time.Time
+}
+
+// publishTime is when a collection in the publish-log was published
+type publishTime struct {
+	collection string
+	time       time.Time
+}
+
+// CheckMasterChanges checks that every file in master modified within the window was last modified by the latest
+// publish of it in the publish-log within the window, as any other change is likely a manual edit or a rogue writer.
+// Previous versions of a page count as published along with the page. Unpublished changes are reported by dir,
+// grouped by time along with the nearest publish, each group identified by the first file modified.
+func (c *Checker) CheckMasterChanges(ctx context.Context) (bool, error) {
+	start, end := c.changeWindow()
+	log.Info(ctx, "checking master changes were published", log.Data{
+		"start": start.Format(time.RFC3339),
+		"end":   end.Format(time.RFC3339),
+	})
+
+	collections, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+	// the time by which the latest publish of each uri, and of each dir for its previous versions, had written it
+	published := make(map[string]time.Time)
+	publishedDirs := make(map[string]time.Time)
+	publishes := make([]publishTime, 0, len(collections))
+	for _, collection := range collections {
+		col, err := c.getPublishedCollection(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+		// without an end date, the publish is taken to have ended by the latest time its name allows. If its name has no
+		// time either, its files are not judged, being taken as written at any time within the window.
+		t, written := col.PublishEndDate.Time, col.PublishEndDate.Time
+		if t.IsZero() {
+			named, _, ok := zebedee.ParsePublishLogName(collection)
+			if ok {
+				t, written = named, named.Add(publishLogDateTolerance)
+			} else {
+				log.Warn(ctx, "skipping changes of published collection without a publish end date or dated name",
+					log.Data{"collection": collection})
+				written = end
+			}
+		}
+		if !t.IsZero() {
+			publishes = append(publishes, publishTime{collection: collection, time: t})
+		}
+
+		err = c.walkPublishedCollection(ctx, collection, func(uri string, d fs.DirEntry) error {
+			if !d.IsDir() {
+				published[uri] = latest(published[uri], written)
+				publishedDirs[path.Dir(uri)] = latest(publishedDirs[path.Dir(uri)], written)
+			}
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	changes := make(map[string][]masterChange)
+	err = c.walkMaster(ctx, func(uri string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		modTime := info.ModTime()
+		if modTime.Before(start) || modTime.After(end) {
+			return nil
+		}
+		written, ok := published[uri]
+		if page, _, isPrevious := strings.Cut(uri, "/"+previousDir+"/"); isPrevious {
+			if dirWritten, dirOK := publishedDirs[page]; dirOK {
+				written, ok = latest(written, dirWritten), true
+			}
+		}
+		if ok && !modTime.After(written.Add(publishWriteTolerance)) {
+			return nil
+		}
+		dir := path.Dir(uri)
+		changes[dir] = append(changes[dir], masterChange{uri: uri, modTime: modTime})
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, dir := range slices.Sorted(maps.Keys(changes)) {
+		for _, group := range groupChanges(changes[dir]) {
+			first, last := group[0].modTime, group[len(group)-1].modTime
+			uris := make([]string, 0, len(group))
+			for _, change := range group {
+				uris = append(uris, change.uri)
+			}
+			log.Info(ctx, "files in master modified outside any publish", log.Data{"dir": dir, "uris": uris})
+
+			files := "1 file"
+			if len(group) > 1 {
+				files = fmt.Sprintf("%d files", len(group))
+			}
+			when := "at " + first.UTC().Format(time.RFC3339)
+			if last.After(first) {
+				when = fmt.Sprintf("between %s and %s", first.UTC().Format(time.RFC3339), last.UTC().Format(time.RFC3339))
+			}
+			message := fmt.Sprintf("%s in '%s' modified %s outside any publish", files, dir, when)
+			if nearest, ok := nearestPublish(publishes, first); ok {
+				message += fmt.Sprintf(", the nearest publish being collection '%s' at %s",
+					nearest.collection, nearest.time.UTC().Format(time.RFC3339))
+			} else {
+				message += ", with no publish within the window"
+			}
+			c.AddFinding(Finding{
+				Check:   CheckMasterChanges,
+				Path:    group[0].uri,
+				Message: message,
+			})
+		}
+	}
+	return len(changes) == 0, nil
+}
+
+// changeWindow returns the times between which changes to master and the publish-log are checked, which run to the end of the last day
+// of the scope if one is set and otherwise CheckPublishedPreviousDays up to now
+func (c *Checker) changeWindow() (time.Time, time.Time) {
+	start, end := c.publishedDateRange()
+	if !c.Scope.To.IsZero() {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// groupChanges sorts the changes to the files of a dir by modification time, splitting them wherever there is a gap
+// longer than changeGroupGap
+func groupChanges(changes []masterChange) [][]masterChange {
+	slices.SortFunc(changes, func(a, b masterChange) int {
+		return cmp.Or(a.modTime.Compare(b.modTime), strings.Compare(a.uri, b.uri))
+	})
+	groups := make([][]masterChange, 0)
+	for i, change := range changes {
+		if i == 0 || change.modTime.Sub(changes[i-1].modTime) > changeGroupGap {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], change)
+	}
+	return groups
+}
+
+// latest returns the later of two times
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// nearestPublish returns the publish closest in time to t, returning false if there are none
+func nearestPublish(publishes []publishTime, t time.Time) (publishTime, bool) {
+	if len(publishes) == 0 {
+		return publishTime{}, false
+	}
+	return slices.MinFunc(publishes, func(a, b publishTime) int {
+		return cmp.Compare(absDuration(a.time.Sub(t)), absDuration(b.time.Sub(t)))
+	}), true
+}
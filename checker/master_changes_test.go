@@ -0,0 +1,114 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckMasterChanges(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	}
+
+	// touch sets the modification time of a file in the zebedee root
+	touch := func(root, fpath string, modTime time.Time) {
+		So(os.Chtimes(path.Join(root, fpath), modTime, modTime), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckMasterChanges, []checkTest{
+		{
+			given: "master files modified within the window by publishes",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/a",
+					"zebedee/master/economy/a/previous/v1",
+					"zebedee/master/economy/old",
+					"zebedee/publish-log/2023-02-09-07-00-collection1/economy/a",
+					"zebedee/publish-log/2023-02-09-10-00-collection2/economy/a",
+				)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-00-collection1.json",
+					[]byte(`{"publishEndDate": "2023-02-09T07:00:25.000Z"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-00-collection2.json", []byte(`{}`)), ShouldBeNil)
+				// a collection with neither an end date nor a time in its name could have published at any time
+				addPages(root, "zebedee/master/economy/c", "zebedee/publish-log/2023-02-09-collection3/economy/c")
+				So(addFile(root, "zebedee/publish-log/2023-02-09-collection3.json", []byte(`{}`)), ShouldBeNil)
+				touch(root, "zebedee/master/economy/c/data.json", time.Date(2023, 2, 9, 8, 0, 0, 0, time.UTC))
+				touch(root, "zebedee/master/economy/a/data.json", time.Date(2023, 2, 9, 10, 0, 20, 0, time.UTC))
+				touch(root, "zebedee/master/economy/a/previous/v1/data.json", time.Date(2023, 2, 9, 7, 0, 21, 0, time.UTC))
+				touch(root, "zebedee/master/economy/old/data.json", time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			given: "master files modified within the window outside any publish",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/a",
+					"zebedee/master/economy/b",
+					"zebedee/master/economy/d",
+					"zebedee/publish-log/2023-02-09-07-00-collection1/economy/a",
+					"zebedee/publish-log/2023-02-09-10-00-collection2/economy/d",
+				)
+				So(addFile(root, "zebedee/master/economy/b/x.csv", []byte("x")), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/b/y.csv", []byte("y")), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-00-collection1.json",
+					[]byte(`{"publishEndDate": "2023-02-09T07:00:25.000Z"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-00-collection2.json", []byte(`{}`)), ShouldBeNil)
+				touch(root, "zebedee/master/economy/a/data.json", time.Date(2023, 2, 9, 7, 0, 20, 0, time.UTC))
+				touch(root, "zebedee/master/economy/b/data.json", time.Date(2023, 2, 9, 7, 30, 0, 0, time.UTC))
+				touch(root, "zebedee/master/economy/b/x.csv", time.Date(2023, 2, 9, 7, 35, 0, 0, time.UTC))
+				touch(root, "zebedee/master/economy/b/y.csv", time.Date(2023, 2, 9, 10, 30, 0, 0, time.UTC))
+				touch(root, "zebedee/master/economy/d/data.json", time.Date(2023, 2, 9, 10, 0, 5, 0, time.UTC))
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckMasterChanges,
+					Path:    "/economy/b/data.json",
+					Message: "2 files in '/economy/b' modified between 2023-02-09T07:30:00Z and 2023-02-09T07:35:00Z outside any publish, the nearest publish being collection '2023-02-09-07-00-collection1' at 2023-02-09T07:00:25Z",
+				},
+				{
+					Check:   checker.CheckMasterChanges,
+					Path:    "/economy/b/y.csv",
+					Message: "1 file in '/economy/b' modified at 2023-02-09T10:30:00Z outside any publish, the nearest publish being collection '2023-02-09-10-00-collection2' at 2023-02-09T10:00:00Z",
+				},
+			},
+		},
+		{
+			given: "published master files modified again after their publish",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/a",
+					"zebedee/master/economy/a/previous/v1",
+					"zebedee/publish-log/2023-02-09-07-00-collection1/economy/a",
+				)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-00-collection1.json",
+					[]byte(`{"publishEndDate": "2023-02-09T07:00:25.000Z"}`)), ShouldBeNil)
+				touch(root, "zebedee/master/economy/a/data.json", time.Date(2023, 2, 9, 9, 0, 0, 0, time.UTC))
+				touch(root, "zebedee/master/economy/a/previous/v1/data.json", time.Date(2023, 2, 9, 9, 30, 0, 0, time.UTC))
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckMasterChanges,
+					Path:    "/economy/a/data.json",
+					Message: "1 file in '/economy/a' modified at 2023-02-09T09:00:00Z outside any publish, the nearest publish being collection '2023-02-09-07-00-collection1' at 2023-02-09T07:00:25Z",
+				},
+				{
+					Check:   checker.CheckMasterChanges,
+					Path:    "/economy/a/previous/v1/data.json",
+					Message: "1 file in '/economy/a/previous/v1' modified at 2023-02-09T09:30:00Z outside any publish, the nearest publish being collection '2023-02-09-07-00-collection1' at 2023-02-09T07:00:25Z",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+// CheckPendingDeletes checks that the uris deleted by each collection published within the window are gone from
+// master, unless a later collection in the publish-log published them again
+func (c *Checker) CheckPendingDeletes(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking pending deletes of published collections were applied to master")
+
+	collections, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var publishLog []string
+	valid := true
+	for _, collection := range collections {
+		deleted, err := c.GetDeletedContent(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+
+		for _, uri := range deleted {
+			if included, _ := c.Scope.includesURI(uri); !included {
+				continue
+			}
+			exists, _, err := c.existsInMaster(uri)
+			if err != nil {
+				return false, err
+			}
+			if !exists {
+				continue
+			}
+
+			if publishLog == nil {
+				if publishLog, err = c.publishLogCollections(); err != nil {
+					return false, err
+				}
+			}
+			republishedBy, err := c.republishedBy(publishLog, collection, uri)
+			if err != nil {
+				return false, err
+			}
+			if republishedBy != "" {
+				log.Info(ctx, "deleted uri published again by a later collection", log.Data{
+					"collection":     collection,
+					"uri":            uri,
+					"republished_by": republishedBy,
+				})
+				continue
+			}
+
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPendingDeletes,
+				Collection: collection,
+				Path:       uri,
+				Message:    fmt.Sprintf("uri deleted by collection '%s' still present in master", collection),
+			})
+		}
+	}
+	return valid, nil
+}
+
+// publishLogCollections returns the dirs of every collection in the publish-log, which sort in the order published
+func (c *Checker) publishLogCollections() ([]string, error) {
+	entries, err := os.ReadDir(path.Join(c.ZebedeeRoot, publish_log))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read publish-log")
+	}
+	collections := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			collections = append(collections, e.Name())
+		}
+	}
+	return collections, nil
+}
+
+// republishedBy returns the first collection in the publish-log published after the collection that includes the uri,
+// or an empty string if there is none
+func (c *Checker) republishedBy(publishLog []string, collection, uri string) (string, error) {
+	for _, later := range publishLog {
+		if later <= collection {
+			continue
+		}
+		_, err := os.Stat(path.Join(c.ZebedeeRoot, publish_log, later, uri))
+		if err == nil {
+			return later, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,70 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckPendingDeletes(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	}
+
+	runCheckTests(t, checker.CheckPendingDeletes, []checkTest{
+		{
+			given: "published collections whose deletes were applied to master",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/b",
+					"zebedee/publish-log/2023-02-08-08-50-collection1/economy/b",
+				)
+				So(addFile(root, "zebedee/publish-log/2023-02-08-08-50-collection1.json",
+					[]byte(`{"pendingDeletes": [{"root": {"uri": "/economy/a"}}]}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "published collections whose deletes were not applied to master",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/a",
+					"zebedee/master/economy/b",
+					"zebedee/master/economy/c",
+					"zebedee/master/economy/c/old",
+					"zebedee/master/economy/d",
+					"zebedee/publish-log/2023-02-08-08-50-collection1/economy/d",
+					"zebedee/publish-log/2023-02-09-10-13-collection2/economy/b",
+					"zebedee/publish-log/2023-02-09-10-13-collection2/economy/c",
+				)
+				So(addFile(root, "zebedee/publish-log/2023-02-08-08-50-collection1.json",
+					[]byte(`{"pendingDeletes": [{"root": {"uri": "/economy/a"}}, {"root": {"uri": "/economy/b"}}]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-10-13-collection2.json",
+					[]byte(`{"pendingDeletes": [{"root": {"uri": "/economy/c/old"}}]}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckPendingDeletes,
+					Collection: "2023-02-08-08-50-collection1",
+					Path:       "/economy/a",
+					Message:    "uri deleted by collection '2023-02-08-08-50-collection1' still present in master",
+				},
+				{
+					Check:      checker.CheckPendingDeletes,
+					Collection: "2023-02-09-10-13-collection2",
+					Path:       "/economy/c/old",
+					Message:    "uri deleted by collection '2023-02-09-10-13-collection2' still present in master",
+				},
+			},
+		},
+	})
+}
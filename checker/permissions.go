@@ -0,0 +1,255 @@
+package checker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+// access bits of a file mode for the owner, shifted right by 3 for the group and 6 for others
+const (
+	readBit  = 0400
+	writeBit = 0200
+	execBit  = 0100
+)
+
+// CheckPermissions walks master, the collections and the collections published within the window, reporting entries
+// that are not readable, or not writable by ZebedeeUID and ZebedeeGID, along with world-writable entries and symlinks
+// that resolve to outside the zebedee root. If the uid or gid is negative, only readability is checked, for the user
+// running the checker. Entries are reported by their uri within master or their collection.
+func (c *Checker) CheckPermissions(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking permissions of zebedee workspace")
+
+	root, err := c.ensureZebedeeRoot()
+	if err != nil {
+		return false, err
+	}
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to resolve zebedee root")
+	}
+	realRoot, err := filepath.EvalSymlinks(absRoot)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to resolve zebedee root")
+	}
+
+	dirs := []string{master, collectionsDir}
+	published, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, col := range published {
+		dirs = append(dirs, path.Join(publish_log, col), path.Join(publish_log, col+".json"))
+	}
+
+	audit := &permissionsAudit{
+		checker:  c,
+		root:     absRoot,
+		realRoot: realRoot,
+		uid:      c.ZebedeeUID,
+		gid:      c.ZebedeeGID,
+		writable: true,
+		valid:    true,
+	}
+	if audit.uid < 0 || audit.gid < 0 {
+		audit.uid, audit.gid, audit.writable = os.Geteuid(), os.Getegid(), false
+	}
+
+	for _, dir := range dirs {
+		if err := audit.walk(ctx, dir); err != nil {
+			return false, err
+		}
+	}
+
+	if !audit.valid {
+		log.Info(ctx, "permission inconsistencies found in zebedee workspace")
+	}
+	return audit.valid, nil
+}
+
+type permissionsAudit struct {
+	checker  *Checker
+	root     string
+	realRoot string
+	uid      int
+	gid      int
+	writable bool
+	valid    bool
+}
+
+// walk audits the dir, relative to the zebedee root, and everything below it. Entries that cannot be read are reported
+// rather than ending the walk.
+func (a *permissionsAudit) walk(ctx context.Context, dir string) error {
+	unreadable := make(map[string]bool)
+	start := filepath.Join(a.root, dir)
+
+	if _, err := os.Lstat(start); os.IsNotExist(err) {
+		log.Info(ctx, "skipping permissions check of missing dir", log.Data{"dir": dir})
+		return nil
+	}
+
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		rel := p[len(a.root)+1:]
+		if err != nil {
+			if !os.IsPermission(err) {
+				return err
+			}
+			if !unreadable[rel] {
+				a.report(rel, "not-readable", "not readable by the checker")
+			}
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if uri, ok := strings.CutPrefix(rel, master); ok && uri != "" {
+			included, ancestor := a.checker.Scope.includesURI(uri)
+			if !included && !ancestor {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // removed since the dir was read
+			}
+			return err
+		}
+		if !a.audit(p, rel, info) {
+			unreadable[rel] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error walking dir tree for permissions check")
+	}
+	return nil
+}
+
+// audit reports any permissions inconsistencies of a single entry, returning false if it is not readable
+func (a *permissionsAudit) audit(p, rel string, info fs.FileInfo) bool {
+	mode := info.Mode()
+
+	if mode&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(p)
+		if err != nil {
+			a.report(rel, "not-readable", "symlink not readable by the checker")
+			return false
+		}
+		// resolve every link on the way to the target, falling back to the nearest existing dir above a missing target
+		if resolved, err := filepath.EvalSymlinks(p); err == nil {
+			target = resolved
+		} else {
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(p), target)
+			}
+			target = resolveAncestor(target)
+		}
+		if r, err := filepath.Rel(a.realRoot, target); err != nil || r == ".." || strings.HasPrefix(r, "../") {
+			a.report(rel, "symlink", fmt.Sprintf("symlink points outside the zebedee root to '%s'", target))
+		}
+		return true
+	}
+
+	if mode.Perm()&0002 != 0 {
+		a.report(rel, "world-writable", "world-writable")
+	}
+
+	uid, gid := -1, -1
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		uid, gid = int(stat.Uid), int(stat.Gid)
+	}
+
+	readable := a.can(mode, uid, gid, readBit)
+	if !readable {
+		a.report(rel, "not-readable", fmt.Sprintf("not readable by uid %d gid %d", a.uid, a.gid))
+	}
+	if a.writable && !a.can(mode, uid, gid, writeBit) {
+		a.report(rel, "not-writable", fmt.Sprintf("not writable by uid %d gid %d", a.uid, a.gid))
+	}
+	return readable
+}
+
+// resolveAncestor resolves the symlinks of the nearest existing dir above a path that does not exist
+func resolveAncestor(p string) string {
+	dir, rest := p, ""
+	for {
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return p
+		}
+		rest = filepath.Join(filepath.Base(dir), rest)
+		dir = parent
+	}
+}
+
+// can reports whether the audited uid and gid have the access given by bit to an entry owned by uid and gid. Dirs also
+// require execute access to be traversed.
+func (a *permissionsAudit) can(mode fs.FileMode, uid, gid int, bit fs.FileMode) bool {
+	if a.uid == 0 {
+		return true
+	}
+	if mode.IsDir() {
+		bit |= execBit
+	}
+	perm := mode.Perm()
+	switch {
+	case uid == a.uid:
+		return perm&bit == bit
+	case gid == a.gid:
+		return perm&(bit>>3) == bit>>3
+	default:
+		return perm&(bit>>6) == bit>>6
+	}
+}
+
+// report records a finding of the entry breaking the rule, as an entry may break more than one
+func (a *permissionsAudit) report(rel, rule, msg string) {
+	a.valid = false
+	collection, uri, where := locate(rel)
+	a.checker.AddFinding(Finding{
+		Check:      CheckPermissions,
+		Collection: collection,
+		Path:       rulePath(uri, rule),
+		Message:    msg + " " + where,
+	})
+}
+
+// locate returns the collection and uri of an entry given by its path relative to the zebedee root, along with a
+// description of where it is. Collection jsons have no uri.
+func locate(rel string) (collection, uri, where string) {
+	if uri, ok := strings.CutPrefix(rel, master); ok {
+		return "", cmp.Or(uri, "/"), "in master"
+	}
+
+	dir, kind := collectionsDir, "collection"
+	if strings.HasPrefix(rel, publish_log) {
+		dir, kind = publish_log, "published collection"
+	}
+	rest := strings.TrimPrefix(strings.TrimPrefix(rel, dir), "/")
+	if rest == "" {
+		return "", "/", "in " + dir
+	}
+	name, sub, _ := strings.Cut(rest, "/")
+	if json, ok := strings.CutSuffix(name, ".json"); ok && sub == "" {
+		return json, "", fmt.Sprintf("in the json of %s '%s'", kind, json)
+	}
+	return name, "/" + sub, fmt.Sprintf("in %s '%s'", kind, name)
+}
@@ -0,0 +1,162 @@
+package checker_test
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 12, 0, 0, 0, time.UTC)
+	}
+
+	// the checker is run for a uid without write access, sharing the workspace with its gid so that only the entries
+	// changed by the cases are reported
+	gid := os.Getegid()
+	forUser := func(chk *checker.Checker) {
+		chk.ZebedeeUID = 54321
+		chk.ZebedeeGID = gid
+	}
+	notAccessible := fmt.Sprintf("by uid 54321 gid %d", gid)
+
+	inconsistent := func(root string) {
+		addPages(root,
+			"zebedee/master/economy/page1",
+			"zebedee/master/economy/page2",
+			"zebedee/collections/col1/inprogress/economy/page1",
+			"zebedee/publish-log/2023-02-09-10-13-collection1/economy/page1",
+		)
+		So(addFile(root, "zebedee/publish-log/2023-02-09-10-13-collection1.json", []byte("{}")), ShouldBeNil)
+		shareWithGroup(root)
+
+		zebedee := path.Join(root, "zebedee")
+		So(os.Chmod(path.Join(zebedee, "master/economy/page1/data.json"), 0666), ShouldBeNil)
+		So(os.Chmod(path.Join(zebedee, "master/economy/page2/data.json"), 0600), ShouldBeNil)
+		So(os.Chmod(path.Join(zebedee, "master/economy/page2"), 0750), ShouldBeNil)
+		So(os.Chmod(path.Join(zebedee, "collections/col1/inprogress/economy/page1/data.json"), 0666), ShouldBeNil)
+		So(os.Chmod(path.Join(zebedee, "publish-log/2023-02-09-10-13-collection1.json"), 0666), ShouldBeNil)
+		So(os.Symlink("/etc", path.Join(zebedee, "master/economy/etc")), ShouldBeNil)
+		So(os.Symlink("etc/passwd", path.Join(zebedee, "master/economy/escape")), ShouldBeNil)
+		So(os.Symlink("page1/data.json", path.Join(zebedee, "master/economy/link")), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckPermissions, []checkTest{
+		{
+			given: "a workspace shared with the zebedee user",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/page1", "zebedee/collections/col1/inprogress/economy/page1")
+				shareWithGroup(root)
+			},
+			configure: forUser,
+		},
+		{
+			given: "a workspace reached through a symlink, with links to missing files within it",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/page1")
+				shareWithGroup(root)
+
+				link := root + "-link"
+				So(os.Symlink(root, link), ShouldBeNil)
+				Reset(func() { os.Remove(link) })
+				economy := path.Join(root, "zebedee/master/economy")
+				So(os.Symlink("page1/missing.json", path.Join(economy, "relative")), ShouldBeNil)
+				So(os.Symlink(path.Join(link, "zebedee/master/economy/missing"), path.Join(economy, "absolute")), ShouldBeNil)
+			},
+			configure: func(chk *checker.Checker) {
+				forUser(chk)
+				chk.ZebedeeRoot += "-link"
+			},
+		},
+		{
+			given:     "a workspace with permission inconsistencies",
+			setup:     inconsistent,
+			configure: forUser,
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckPermissions,
+					Path:    "/economy/escape#symlink",
+					Message: "symlink points outside the zebedee root to '/etc/passwd' in master",
+				},
+				{
+					Check:   checker.CheckPermissions,
+					Path:    "/economy/etc#symlink",
+					Message: "symlink points outside the zebedee root to '/etc' in master",
+				},
+				{Check: checker.CheckPermissions, Path: "/economy/page1/data.json#world-writable", Message: "world-writable in master"},
+				{Check: checker.CheckPermissions, Path: "/economy/page2#not-writable", Message: "not writable " + notAccessible + " in master"},
+				{
+					Check:   checker.CheckPermissions,
+					Path:    "/economy/page2/data.json#not-readable",
+					Message: "not readable " + notAccessible + " in master",
+				},
+				{
+					Check:   checker.CheckPermissions,
+					Path:    "/economy/page2/data.json#not-writable",
+					Message: "not writable " + notAccessible + " in master",
+				},
+				{
+					Check:      checker.CheckPermissions,
+					Collection: "col1",
+					Path:       "/inprogress/economy/page1/data.json#world-writable",
+					Message:    "world-writable in collection 'col1'",
+				},
+				{
+					Check:      checker.CheckPermissions,
+					Collection: "2023-02-09-10-13-collection1",
+					Path:       "#world-writable",
+					Message:    "world-writable in the json of published collection '2023-02-09-10-13-collection1'",
+				},
+			},
+		},
+		{
+			given: "a workspace with permission inconsistencies, scoped to a page",
+			setup: inconsistent,
+			configure: func(chk *checker.Checker) {
+				forUser(chk)
+				chk.Scope = checker.Scope{URIPrefix: "/economy/page1"}
+			},
+			findings: []checker.Finding{
+				{Check: checker.CheckPermissions, Path: "/economy/page1/data.json#world-writable", Message: "world-writable in master"},
+				{
+					Check:      checker.CheckPermissions,
+					Collection: "col1",
+					Path:       "/inprogress/economy/page1/data.json#world-writable",
+					Message:    "world-writable in collection 'col1'",
+				},
+				{
+					Check:      checker.CheckPermissions,
+					Collection: "2023-02-09-10-13-collection1",
+					Path:       "#world-writable",
+					Message:    "world-writable in the json of published collection '2023-02-09-10-13-collection1'",
+				},
+			},
+		},
+	})
+}
+
+// shareWithGroup gives the group of the workspace the same access as its owner
+func shareWithGroup(root string) {
+	err := filepath.WalkDir(path.Join(root, "zebedee"), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.Chmod(p, 0770)
+		}
+		return os.Chmod(p, 0660)
+	})
+	So(err, ShouldBeNil)
+}
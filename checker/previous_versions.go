@@ -0,0 +1,143 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+var versionDirPattern = regexp.MustCompile(`^v([1-9][0-9]*)$`)
+
+// CheckPreviousVersions checks that the superseded versions listed in each master page's data.json exist under its
+// 'previous' dir with a valid data.json, that every version dir on disk is listed, and that versions are numbered
+// without gaps
+func (c *Checker) CheckPreviousVersions(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking previous versions of pages in master")
+
+	valid := true
+	err := c.walkMasterPages(ctx, func(uri string, page *zebedee.Page) error {
+		pageValid, err := c.checkPageVersions(ctx, uri, page)
+		if err != nil {
+			return err
+		}
+		valid = pageValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkPageVersions(ctx context.Context, uri string, page *zebedee.Page) (bool, error) {
+	valid := true
+	addFinding := func(versionURI, msg string) {
+		valid = false
+		c.AddFinding(Finding{Check: CheckPreviousVersions, Path: versionURI, Message: msg})
+	}
+
+	previousURI := path.Join(uri, previousDir)
+	numbers := make(map[int]bool)
+	listed := make(map[string]bool)
+
+	for _, v := range page.Versions {
+		versionURI := path.Clean(v.URI)
+		listed[versionURI] = true
+		if path.Dir(versionURI) == previousURI {
+			if n, ok := versionNumber(path.Base(versionURI)); ok {
+				numbers[n] = true
+			}
+		}
+
+		exists, err := c.isValidPage(versionURI)
+		if err != nil {
+			return false, err
+		}
+		switch exists {
+		case pageMissing:
+			addFinding(versionURI, fmt.Sprintf("previous version listed by page '%s' missing from master", uri))
+		case pageInvalid:
+			addFinding(versionURI, fmt.Sprintf("previous version listed by page '%s' has invalid data.json", uri))
+		}
+	}
+
+	entries, err := os.ReadDir(c.masterPath(previousURI))
+	if err != nil && !os.IsNotExist(err) {
+		return false, err
+	}
+	for _, e := range entries {
+		n, ok := versionNumber(e.Name())
+		if !e.IsDir() || !ok {
+			continue
+		}
+		numbers[n] = true
+		versionURI := path.Join(previousURI, e.Name())
+		if !listed[versionURI] {
+			addFinding(versionURI, fmt.Sprintf("previous version dir not listed by page '%s'", uri))
+		}
+	}
+
+	for _, n := range missingNumbers(numbers) {
+		addFinding(path.Join(previousURI, "v"+strconv.Itoa(n)),
+			fmt.Sprintf("gap in previous version numbering of page '%s'", uri))
+	}
+
+	if !valid {
+		log.Info(ctx, "inconsistent previous versions of page", log.Data{"uri": uri})
+	}
+	return valid, nil
+}
+
+type pageState int
+
+const (
+	pageValid pageState = iota
+	pageMissing
+	pageInvalid
+)
+
+// isValidPage reports whether the uri in master holds a data.json that can be decoded
+func (c *Checker) isValidPage(uri string) (pageState, error) {
+	_, err := zebedee.GetPageFromFile(path.Join(c.masterPath(uri), dataJSON))
+	switch {
+	case err == nil:
+		return pageValid, nil
+	case os.IsNotExist(err):
+		return pageMissing, nil
+	case isDecodeError(err):
+		return pageInvalid, nil
+	default:
+		return pageMissing, err
+	}
+}
+
+func versionNumber(name string) (int, bool) {
+	m := versionDirPattern.FindStringSubmatch(name)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m[1])
+	return n, err == nil
+}
+
+// missingNumbers returns the numbers missing from the sequence 1 to the highest number present
+func missingNumbers(numbers map[int]bool) []int {
+	highest := 0
+	for n := range numbers {
+		highest = max(highest, n)
+	}
+	missing := make([]int, 0)
+	for n := 1; n < highest; n++ {
+		if !numbers[n] {
+			missing = append(missing, n)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,82 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckPreviousVersions(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// v2 is listed but missing, v3 is invalid, v4 is unlisted and v5 is neither listed nor on disk
+	inconsistentVersions := func(root string) {
+		So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{"type": "bulletin", "versions": [
+			{"uri": "/bulletins/b1/previous/v1"}, {"uri": "/bulletins/b1/previous/v2"},
+			{"uri": "/bulletins/b1/previous/v3"}, {"uri": "/bulletins/b1/previous/v6"}
+		]}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/bulletins/b1/previous/v1/data.json", []byte(`{}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/bulletins/b1/previous/v3/data.json", []byte(`{`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/bulletins/b1/previous/v4/data.json", []byte(`{}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/bulletins/b1/previous/v6/data.json", []byte(`{}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/articles/a1/data.json", []byte(`{"type": "article"}`)), ShouldBeNil)
+		So(addFile(root, "zebedee/master/articles/a1/previous/v1/data.json", []byte(`{}`)), ShouldBeNil)
+	}
+	unlistedArticleVersion := checker.Finding{
+		Check:   checker.CheckPreviousVersions,
+		Path:    "/articles/a1/previous/v1",
+		Message: "previous version dir not listed by page '/articles/a1'",
+	}
+
+	runCheckTests(t, checker.CheckPreviousVersions, []checkTest{
+		{
+			given: "a master page with consistent previous versions",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{"type": "bulletin", "versions": [
+					{"uri": "/bulletins/b1/previous/v1"}, {"uri": "/bulletins/b1/previous/v2"}
+				]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/previous/v1/data.json", []byte(`{}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/previous/v2/data.json", []byte(`{}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "master pages with inconsistent previous versions",
+			setup: inconsistentVersions,
+			findings: []checker.Finding{
+				unlistedArticleVersion,
+				{
+					Check:   checker.CheckPreviousVersions,
+					Path:    "/bulletins/b1/previous/v2",
+					Message: "previous version listed by page '/bulletins/b1' missing from master",
+				},
+				{
+					Check:   checker.CheckPreviousVersions,
+					Path:    "/bulletins/b1/previous/v3",
+					Message: "previous version listed by page '/bulletins/b1' has invalid data.json",
+				},
+				{
+					Check:   checker.CheckPreviousVersions,
+					Path:    "/bulletins/b1/previous/v4",
+					Message: "previous version dir not listed by page '/bulletins/b1'",
+				},
+				{
+					Check:   checker.CheckPreviousVersions,
+					Path:    "/bulletins/b1/previous/v5",
+					Message: "gap in previous version numbering of page '/bulletins/b1'",
+				},
+			},
+		},
+		{
+			given:     "master pages with inconsistent previous versions and a run scoped to one subtree",
+			setup:     inconsistentVersions,
+			configure: func(chk *checker.Checker) { chk.Scope = checker.Scope{URIPrefix: "/articles"} },
+			findings:  []checker.Finding{unlistedArticleVersion},
+		},
+	})
+}
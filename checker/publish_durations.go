@@ -0,0 +1,169 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// defaults for judging publish durations, used unless SlowPublishAfter and SlowPublishFactor are set
+const (
+	defaultSlowPublishAfter  = 5 * time.Minute
+	defaultSlowPublishFactor = 10
+)
+
+// the rolling median of publish durations is taken over up to medianWindow preceding publishes, including those in the
+// publish-log before the window, and only used once there are at least minMedianSamples of them
+const (
+	medianWindow     = 20
+	minMedianSamples = 5
+)
+
+// CheckPublishDurations checks the publish start and end dates recorded by each collection published within the
+// window, reporting end dates in the future or before the start, and publishes that took longer than SlowPublishAfter
+// or SlowPublishFactor times the rolling median of the publishes before them, whether or not within the window, as slow
+// publishes are an early warning of trouble with the shared filesystem
+func (c *Checker) CheckPublishDurations(ctx context.Context) (bool, error) {
+	threshold := c.SlowPublishAfter
+	if threshold <= 0 {
+		threshold = defaultSlowPublishAfter
+	}
+	factor := c.SlowPublishFactor
+	if factor <= 0 {
+		factor = defaultSlowPublishFactor
+	}
+	log.Info(ctx, "checking publish durations of published collections", log.Data{
+		"threshold":     threshold.String(),
+		"median_factor": factor,
+	})
+
+	collections, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	durations, err := c.durationsBeforeWindow(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	now := Now()
+	valid := true
+	for _, collection := range collections {
+		col, err := c.getPublishedCollection(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+		start, end := col.PublishStartDate.Time, col.PublishEndDate.Time
+		if start.IsZero() || end.IsZero() {
+			log.Info(ctx, "published collection has no publish start or end date", log.Data{"collection": collection})
+			continue
+		}
+
+		if end.After(now) {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishDurations,
+				Collection: collection,
+				Path:       rulePath("", "/publishEndDate"),
+				Message: fmt.Sprintf("publish end date %s of collection '%s' is in the future",
+					end.UTC().Format(time.RFC3339), collection),
+			})
+			continue
+		}
+		if end.Before(start) {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishDurations,
+				Collection: collection,
+				Path:       rulePath("", "/publishEndDate"),
+				Message: fmt.Sprintf("publish end date %s of collection '%s' is before its publish start date %s",
+					end.UTC().Format(time.RFC3339), collection, start.UTC().Format(time.RFC3339)),
+			})
+			continue
+		}
+
+		duration := end.Sub(start)
+		preceding := durations[max(0, len(durations)-medianWindow):]
+		durations = append(durations, duration)
+
+		if duration > threshold {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishDurations,
+				Collection: collection,
+				Path:       rulePath("", "threshold"),
+				Message: fmt.Sprintf("collection '%s' took %s to publish, over the threshold of %s",
+					collection, duration, threshold),
+			})
+			continue
+		}
+		if len(preceding) < minMedianSamples {
+			continue
+		}
+		if m := median(preceding); duration > time.Duration(factor*float64(m)) {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishDurations,
+				Collection: collection,
+				Path:       rulePath("", "median"),
+				Message: fmt.Sprintf("collection '%s' took %s to publish, over %g times the median of %s of the %d publishes before it",
+					collection, duration, factor, m, len(preceding)),
+			})
+		}
+	}
+	return valid, nil
+}
+
+// durationsBeforeWindow returns the publish durations of up to medianWindow collections published on the days before
+// the window, oldest first, for the median of the first publishes within the window. Collections without a valid
+// publish start and end date are passed over.
+func (c *Checker) durationsBeforeWindow(ctx context.Context) ([]time.Duration, error) {
+	start, _ := c.publishedDateRange()
+	windowStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	entries, err := os.ReadDir(path.Join(c.ZebedeeRoot, publish_log))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read publish-log")
+	}
+
+	durations := make([]time.Duration, 0, medianWindow)
+	for i := len(entries) - 1; i >= 0 && len(durations) < medianWindow; i-- {
+		if !entries[i].IsDir() {
+			continue
+		}
+		collection := entries[i].Name()
+		if date, _, ok := zebedee.ParsePublishLogName(collection); !ok || !date.Before(windowStart) {
+			continue
+		}
+		col, err := c.getPublishedCollection(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		start, end := col.PublishStartDate.Time, col.PublishEndDate.Time
+		if !start.IsZero() && !end.IsZero() && !end.Before(start) {
+			durations = append(durations, end.Sub(start))
+		}
+	}
+	slices.Reverse(durations)
+	log.Info(ctx, "found publish durations before the window", log.Data{"count": len(durations)})
+	return durations, nil
+}
+
+// median returns the median of the durations, which must not be empty
+func median(durations []time.Duration) time.Duration {
+	sorted := slices.Sorted(slices.Values(durations))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,111 @@
+package checker_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckPublishDurations(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	}
+
+	// addPublish adds a published collection to the publish-log that took the given seconds to publish
+	addPublish := func(root, collection, start string, seconds int) {
+		startDate, err := time.Parse(time.RFC3339, start)
+		So(err, ShouldBeNil)
+		endDate := startDate.Add(time.Duration(seconds) * time.Second)
+		addPages(root, "zebedee/publish-log/"+collection+"/economy/"+collection)
+		So(addFile(root, "zebedee/publish-log/"+collection+".json", fmt.Appendf(nil,
+			`{"publishStartDate": %q, "publishEndDate": %q}`,
+			startDate.Format(time.RFC3339), endDate.Format(time.RFC3339))), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckPublishDurations, []checkTest{
+		{
+			given: "collections published within the window in a steady time",
+			setup: func(root string) {
+				for i := range 6 {
+					addPublish(root, fmt.Sprintf("2023-02-09-0%d-30-collection%d", i, i),
+						fmt.Sprintf("2023-02-09T0%d:30:00Z", i), 10+i)
+				}
+				// collections without publish dates are not checked
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-30-undated.json", []byte("{}")), ShouldBeNil)
+				addPages(root, "zebedee/publish-log/2023-02-09-07-30-undated/economy/undated")
+			},
+		},
+		{
+			given: "a slow publish within the window following steady publishes before it",
+			setup: func(root string) {
+				for i := range 5 {
+					addPublish(root, fmt.Sprintf("2023-02-0%d-07-00-collection%d", 3+i, i),
+						fmt.Sprintf("2023-02-0%dT07:00:00Z", 3+i), 10)
+				}
+				addPublish(root, "2023-02-09-06-30-slower", "2023-02-09T06:30:00Z", 120)
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckPublishDurations,
+					Collection: "2023-02-09-06-30-slower",
+					Path:       "#median",
+					Message:    "collection '2023-02-09-06-30-slower' took 2m0s to publish, over 10 times the median of 10s of the 5 publishes before it",
+				},
+			},
+		},
+		{
+			given: "collections published within the window with anomalous timings",
+			setup: func(root string) {
+				for i := range 5 {
+					addPublish(root, fmt.Sprintf("2023-02-09-0%d-30-collection%d", i, i),
+						fmt.Sprintf("2023-02-09T0%d:30:00Z", i), 10)
+				}
+				addPublish(root, "2023-02-09-06-30-slower", "2023-02-09T06:30:00Z", 120)
+				addPublish(root, "2023-02-09-07-30-slowest", "2023-02-09T07:30:00Z", 600)
+				addPublish(root, "2023-02-09-08-30-backwards", "2023-02-09T08:30:00Z", -5)
+				addPublish(root, "2023-02-09-10-59-future", "2023-02-09T10:59:00Z", 300)
+			},
+			configure: func(chk *checker.Checker) {
+				chk.SlowPublishAfter = 5 * time.Minute
+				chk.SlowPublishFactor = 10
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckPublishDurations,
+					Collection: "2023-02-09-06-30-slower",
+					Path:       "#median",
+					Message:    "collection '2023-02-09-06-30-slower' took 2m0s to publish, over 10 times the median of 10s of the 5 publishes before it",
+				},
+				{
+					Check:      checker.CheckPublishDurations,
+					Collection: "2023-02-09-07-30-slowest",
+					Path:       "#threshold",
+					Message:    "collection '2023-02-09-07-30-slowest' took 10m0s to publish, over the threshold of 5m0s",
+				},
+				{
+					Check:      checker.CheckPublishDurations,
+					Collection: "2023-02-09-08-30-backwards",
+					Path:       "#/publishEndDate",
+					Message:    "publish end date 2023-02-09T08:29:55Z of collection '2023-02-09-08-30-backwards' is before its publish start date 2023-02-09T08:30:00Z",
+				},
+				{
+					Check:      checker.CheckPublishDurations,
+					Collection: "2023-02-09-10-59-future",
+					Path:       "#/publishEndDate",
+					Message:    "publish end date 2023-02-09T11:04:00Z of collection '2023-02-09-10-59-future' is in the future",
+				},
+			},
+		},
+	})
+}
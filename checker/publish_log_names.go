@@ -0,0 +1,115 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// publishLogDateTolerance is how far the date and time in the name of a collection in the publish-log may be from
+// its publish end date, allowing for the name being written at the start of the publish
+const publishLogDateTolerance = 10 * time.Minute
+
+// CheckPublishLogNames checks that every collection in the publish-log modified within the window is named in the
+// format 2006-01-02-15-04-<collection>, as the names of collections named otherwise cannot be checked against their
+// json, and that the date and collection in the names of those published within the window match the publish end date
+// and name in their json
+func (c *Checker) CheckPublishLogNames(ctx context.Context) (bool, error) {
+	start, end := c.changeWindow()
+	log.Info(ctx, "checking names of collections in the publish-log", log.Data{
+		"start": start.Format(time.RFC3339),
+		"end":   end.Format(time.RFC3339),
+	})
+
+	entries, err := os.ReadDir(path.Join(c.ZebedeeRoot, publish_log))
+	if err != nil {
+		return false, errors.Wrap(err, "unable to read publish-log")
+	}
+
+	valid := true
+	names := make(map[string]bool)
+	for _, e := range entries {
+		if !e.IsDir() && !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), ".json")
+		if names[name] || !c.Scope.includesCollection(name) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // removed since the publish-log was read
+			}
+			return false, errors.Wrap(err, "unable to read publish-log")
+		}
+		if info.ModTime().Before(start) || info.ModTime().After(end) {
+			continue
+		}
+		names[name] = true
+
+		if _, _, ok := zebedee.ParsePublishLogName(name); !ok {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishLogNames,
+				Collection: name,
+				Message:    fmt.Sprintf("publish-log collection '%s' is not named in the format 'yyyy-mm-dd-hh-mm-<collection>'", name),
+			})
+		}
+	}
+
+	collections, err := c.GetPublishedCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, collection := range collections {
+		date, filename, ok := zebedee.ParsePublishLogName(collection)
+		if !ok {
+			continue
+		}
+		col, err := c.getPublishedCollection(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+
+		end := col.PublishEndDate.Time
+		if !end.IsZero() && absDuration(end.Truncate(time.Minute).Sub(date)) > publishLogDateTolerance {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishLogNames,
+				Collection: collection,
+				Path:       rulePath("", "/publishEndDate"),
+				Message: fmt.Sprintf("publish-log collection '%s' is named for %s but its publish end date is %s",
+					collection, date.Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
+			})
+		}
+
+		if col.Name != "" && zebedee.CollectionFilename(col.Name) != filename {
+			valid = false
+			c.AddFinding(Finding{
+				Check:      CheckPublishLogNames,
+				Collection: collection,
+				Path:       rulePath("", "/name"),
+				Message: fmt.Sprintf("publish-log collection '%s' is named for collection '%s' but its json is of collection '%s'",
+					collection, filename, col.Name),
+			})
+		}
+	}
+
+	return valid, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,88 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckPublishLogNames(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	}
+
+	runCheckTests(t, checker.CheckPublishLogNames, []checkTest{
+		{
+			given: "a publish-log whose names match the json of its collections",
+			setup: func(root string) {
+				addPages(root, "zebedee/publish-log/2023-02-09-07-00-collection1/economy/a")
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-00-collection1.json",
+					[]byte(`{"name": "Collection 1", "publishEndDate": "2023-02-09T07:00:25.000Z"}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "a publish-log with misnamed and misdated collections",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/publish-log/2023-02-09-07-00-collection1/economy/a",
+					"zebedee/publish-log/2023-02-09-08-00-collection2/economy/b",
+					"zebedee/publish-log/collection3/economy/c",
+					"zebedee/publish-log/2023-02-09-collection5/economy/e",
+					"zebedee/publish-log/collection0/economy/z",
+				)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-07-00-collection1.json",
+					[]byte(`{"name": "Collection 1", "publishEndDate": "2023-02-09T09:30:00.000Z"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-08-00-collection2.json",
+					[]byte(`{"name": "Collection 4", "publishEndDate": "2023-02-09T08:00:10.000Z"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/collection3.json", []byte(`{"name": "Collection 3"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/2023-02-09-collection5.json", []byte(`{"name": "Collection 5"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/publish-log/collection0.json", []byte(`{"name": "Collection 0"}`)), ShouldBeNil)
+
+				// collections are checked by when they were last modified, so collection0 falls before the window
+				modified := time.Date(2023, 2, 9, 8, 0, 0, 0, time.UTC)
+				for _, name := range []string{"2023-02-09-collection5", "2023-02-09-collection5.json", "collection3", "collection3.json"} {
+					So(os.Chtimes(path.Join(root, "zebedee/publish-log", name), modified, modified), ShouldBeNil)
+				}
+				old := time.Date(2023, 1, 9, 8, 0, 0, 0, time.UTC)
+				for _, name := range []string{"collection0", "collection0.json"} {
+					So(os.Chtimes(path.Join(root, "zebedee/publish-log", name), old, old), ShouldBeNil)
+				}
+			},
+			findings: []checker.Finding{
+				{
+					Check:      checker.CheckPublishLogNames,
+					Collection: "2023-02-09-collection5",
+					Message:    "publish-log collection '2023-02-09-collection5' is not named in the format 'yyyy-mm-dd-hh-mm-<collection>'",
+				},
+				{
+					Check:      checker.CheckPublishLogNames,
+					Collection: "collection3",
+					Message:    "publish-log collection 'collection3' is not named in the format 'yyyy-mm-dd-hh-mm-<collection>'",
+				},
+				{
+					Check:      checker.CheckPublishLogNames,
+					Collection: "2023-02-09-07-00-collection1",
+					Path:       "#/publishEndDate",
+					Message:    "publish-log collection '2023-02-09-07-00-collection1' is named for 2023-02-09T07:00:00Z but its publish end date is 2023-02-09T09:30:00Z",
+				},
+				{
+					Check:      checker.CheckPublishLogNames,
+					Collection: "2023-02-09-08-00-collection2",
+					Path:       "#/name",
+					Message:    "publish-log collection '2023-02-09-08-00-collection2' is named for collection 'collection2' but its json is of collection 'Collection 4'",
+				},
+			},
+		},
+	})
+}
@@ -56,9 +56,8 @@ func (c *Checker) CheckPublishedCollections(ctx context.Context) (bool, error) {
 }
 
 func (c *Checker) GetDeletedContent(ctx context.Context, collection string) ([]string, error) {
-	filename := path.Join(c.ZebedeeRoot, publish_log, collection+".json")
 	deleted := make([]string, 0)
-	col, err := zebedee.GetCollectionFromFile(filename)
+	col, err := c.getPublishedCollection(ctx, collection)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +69,32 @@ func (c *Checker) GetDeletedContent(ctx context.Context, collection string) ([]s
 	return deleted, nil
 }
 
+// getPublishedCollection reads the json of a collection in the publish-log. Fields that cannot be decoded are logged
+// and left unset, as is the whole collection if its json is not an object, so that one bad file does not stop the run.
+func (c *Checker) getPublishedCollection(ctx context.Context, collection string) (*zebedee.PublishedCollection, error) {
+	filename := path.Join(c.ZebedeeRoot, publish_log, collection+".json")
+	logData := log.Data{"collection": collection}
+
+	col, warnings, err := zebedee.GetPublishedCollectionFromFile(filename)
+	if err != nil {
+		if !isDecodeError(err) {
+			return nil, err
+		}
+		logData["error"] = err.Error()
+		log.Warn(ctx, "unable to decode published collection json", logData)
+		return &zebedee.PublishedCollection{}, nil
+	}
+	if len(warnings) > 0 {
+		fields := make([]string, 0, len(warnings))
+		for _, w := range warnings {
+			fields = append(fields, w.String())
+		}
+		logData["fields"] = fields
+		log.Warn(ctx, "fields of published collection json not decoded", logData)
+	}
+	return col, nil
+}
+
 func (c *Checker) CheckPublishedCollection(ctx context.Context, collection string, allDeleted allDeleted) (bool, error) {
 	logdata := log.Data{"collection": collection}
 	log.Info(ctx, "checking published collection", logdata)
@@ -96,10 +121,13 @@ func (c *Checker) GetPublishedCollections(ctx context.Context) ([]string, error)
 
 	collections := make([]string, 0)
 
-	startDate := Now().AddDate(0, 0, -c.CheckPublishedPreviousDays)
-	log.Info(ctx, "getting list of published collections", log.Data{"start_date": startDate.Format("2006-01-02")})
+	startDate, endDate := c.publishedDateRange()
+	log.Info(ctx, "getting list of published collections", log.Data{
+		"start_date": startDate.Format("2006-01-02"),
+		"end_date":   endDate.Format("2006-01-02"),
+	})
 
-	for checkDate := startDate; !checkDate.After(Now()); checkDate = checkDate.AddDate(0, 0, 1) {
+	for checkDate := startDate; !checkDate.After(endDate); checkDate = checkDate.AddDate(0, 0, 1) {
 		glob := path.Join(root, publish_log, checkDate.Format("2006-01-02")+"*")
 		matches, err := filepath.Glob(glob)
 		if err != nil {
@@ -107,7 +135,7 @@ func (c *Checker) GetPublishedCollections(ctx context.Context) ([]string, error)
 		}
 		for _, match := range matches {
 			col := path.Base(match)
-			if !strings.HasSuffix(col, ".json") {
+			if !strings.HasSuffix(col, ".json") && c.Scope.includesCollection(col) {
 				collections = append(collections, col)
 			}
 		}
@@ -117,6 +145,21 @@ func (c *Checker) GetPublishedCollections(ctx context.Context) ([]string, error)
 	return collections, nil
 }
 
+// publishedDateRange returns the days to search the publish-log over, using the checker scope if one is set and
+// otherwise the CheckPublishedPreviousDays up to now. A scope setting only To covers CheckPublishedPreviousDays up to
+// that date.
+func (c *Checker) publishedDateRange() (time.Time, time.Time) {
+	endDate := Now()
+	if !c.Scope.To.IsZero() {
+		endDate = c.Scope.To
+	}
+	startDate := endDate.AddDate(0, 0, -c.CheckPublishedPreviousDays)
+	if !c.Scope.From.IsZero() {
+		startDate = c.Scope.From
+	}
+	return startDate, endDate
+}
+
 func (c *Checker) CheckDirsInPublishedCollection(ctx context.Context, collection string, allDeleted allDeleted) (bool, error) {
 	root, err := c.ensureZebedeeRoot()
 	if err != nil {
@@ -140,6 +183,15 @@ func (c *Checker) CheckDirsInPublishedCollection(ctx context.Context, collection
 				return nil
 			}
 
+			// skip dirs outside the scope, walking down through ancestors of the scoped uri
+			included, ancestor := c.Scope.includesURI(relativePath)
+			if ancestor {
+				return nil
+			}
+			if !included {
+				return filepath.SkipDir
+			}
+
 			inMaster, err := c.IsDirInMaster(ctx, relativePath)
 			if err != nil {
 				return err
@@ -158,7 +210,14 @@ func (c *Checker) CheckDirsInPublishedCollection(ctx context.Context, collection
 	if len(missingDirs) > 0 {
 		logData["missing_dirs"] = missingDirs
 		log.Info(ctx, "dirs from collection missing from publishing master", logData)
-		c.AddInconsistency(fmt.Sprintf("dirs from collection '%s' missing from publishing master", collection))
+		for _, dir := range missingDirs {
+			c.AddFinding(Finding{
+				Check:      CheckPublishedDirs,
+				Collection: collection,
+				Path:       dir,
+				Message:    fmt.Sprintf("dirs from collection '%s' missing from publishing master", collection),
+			})
+		}
 		return false, nil
 	}
 	return true, nil
@@ -179,3 +238,40 @@ func (c *Checker) IsDirInMaster(ctx context.Context, subdir string) (bool, error
 	}
 	return true, nil
 }
+
+// walkPublishedCollection calls fn with the uri of every dir and file within the scope in a publish-log collection,
+// walking down through ancestors of the scoped uri without calling fn for them. As with filepath.WalkDir, fn may
+// return filepath.SkipDir.
+func (c *Checker) walkPublishedCollection(ctx context.Context, collection string, fn func(uri string, d fs.DirEntry) error) error {
+	root, err := c.ensureZebedeeRoot()
+	if err != nil {
+		return err
+	}
+	coldir := path.Join(root, publish_log, collection)
+
+	err = filepath.WalkDir(coldir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		uri := p[len(coldir):]
+		if uri == "" {
+			return nil
+		}
+
+		included, ancestor := c.Scope.includesURI(uri)
+		if ancestor {
+			return nil
+		}
+		if !included {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(uri, d)
+	})
+	if err != nil {
+		return errors.Wrap(err, "error walking published collection dir tree")
+	}
+	return nil
+}
@@ -226,3 +226,153 @@ func TestCheckDirsInPublishedCollection_UndefinedZebedeeRoot(t *testing.T) {
 		})
 	})
 }
+
+func TestGetPublishedCollections_Scoped(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+	Convey("Given a workspace with collections from five days", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		addDirs(tempZebedeeRoot,
+			"zebedee/publish-log/2023-02-06-08-50-col0test",
+			"zebedee/publish-log/2023-02-07-08-50-col1test",
+			"zebedee/publish-log/2023-02-08-08-50-col2test",
+			"zebedee/publish-log/2023-02-08-11-17-col3test",
+			"zebedee/publish-log/2023-02-09-12-13-collection4",
+		)
+
+		// Override current time in checker package
+		checker.Now = func() time.Time {
+			return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+		}
+
+		Convey("When GetPublishedCollections is run by a checker scoped to a date range", func() {
+			chk := checker.Checker{
+				ZebedeeRoot: tempZebedeeRoot,
+				Scope: checker.Scope{
+					From: time.Date(2023, 2, 6, 0, 0, 0, 0, time.UTC),
+					To:   time.Date(2023, 2, 7, 0, 0, 0, 0, time.UTC),
+				},
+			}
+			cols, err := chk.GetPublishedCollections(context.Background())
+
+			Convey("Then only the collections from the scoped date range should be returned", func() {
+				So(err, ShouldBeNil)
+				So(cols, ShouldResemble, []string{"2023-02-06-08-50-col0test", "2023-02-07-08-50-col1test"})
+			})
+		})
+
+		Convey("When GetPublishedCollections is run by a checker scoped to a date range with only an end", func() {
+			chk := checker.Checker{
+				ZebedeeRoot:                tempZebedeeRoot,
+				CheckPublishedPreviousDays: 1,
+				Scope:                      checker.Scope{To: time.Date(2023, 2, 7, 0, 0, 0, 0, time.UTC)},
+			}
+			cols, err := chk.GetPublishedCollections(context.Background())
+
+			Convey("Then the collections from the previous days up to the end should be returned", func() {
+				So(err, ShouldBeNil)
+				So(cols, ShouldResemble, []string{"2023-02-06-08-50-col0test", "2023-02-07-08-50-col1test"})
+			})
+		})
+
+		Convey("When GetPublishedCollections is run by a checker scoped to a collection name", func() {
+			chk := checker.Checker{
+				ZebedeeRoot:                tempZebedeeRoot,
+				CheckPublishedPreviousDays: 3,
+				Scope:                      checker.Scope{Collection: "col2test"},
+			}
+			cols, err := chk.GetPublishedCollections(context.Background())
+
+			Convey("Then only the named collection should be returned", func() {
+				So(err, ShouldBeNil)
+				So(cols, ShouldResemble, []string{"2023-02-08-08-50-col2test"})
+			})
+		})
+	})
+}
+
+func TestCheckDirsInPublishedCollection_ScopedURIPrefix(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a workspace with a published collection missing dirs from master", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		addDirs(tempZebedeeRoot,
+			"zebedee/master/economy/somepage/v1",
+			"zebedee/publish-log/2023-02-09-12-13-collection1/economy/somepage/v1",
+			"zebedee/publish-log/2023-02-09-12-13-collection1/people/otherpage",
+		)
+
+		Convey("When CheckDirsInPublishedCollection is run by a checker scoped to the consistent subtree", func() {
+			chk := checker.Checker{
+				ZebedeeRoot: tempZebedeeRoot,
+				Scope:       checker.Scope{URIPrefix: "/economy/somepage"},
+			}
+			valid, err := chk.CheckDirsInPublishedCollection(context.Background(), "2023-02-09-12-13-collection1", []string{})
+
+			Convey("Then the collection should be consistent", func() {
+				So(err, ShouldBeNil)
+				So(valid, ShouldBeTrue)
+			})
+		})
+
+		Convey("When CheckDirsInPublishedCollection is run by a checker scoped to the inconsistent subtree", func() {
+			chk := checker.Checker{
+				ZebedeeRoot: tempZebedeeRoot,
+				Scope:       checker.Scope{URIPrefix: "people/"},
+			}
+			valid, err := chk.CheckDirsInPublishedCollection(context.Background(), "2023-02-09-12-13-collection1", []string{})
+
+			Convey("Then the collection should be inconsistent", func() {
+				So(err, ShouldBeNil)
+				So(valid, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestCheckPublishedCollections_UndecodableJSON(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given published collections with malformed json", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		addPages(tempZebedeeRoot,
+			"zebedee/master/somepage",
+			"zebedee/publish-log/2023-02-09-10-13-collection1/somepage",
+			"zebedee/publish-log/2023-02-09-12-13-collection2/someotherpage",
+		)
+		So(addFile(tempZebedeeRoot, "zebedee/publish-log/2023-02-09-10-13-collection1.json", []byte(`[]`)), ShouldBeNil)
+		So(addFile(tempZebedeeRoot, "zebedee/publish-log/2023-02-09-12-13-collection2.json", []byte(`{
+			"publishEndDate": "yesterday", "pendingDeletes": [{"root": {"uri": "/someotherpage"}}]
+		}`)), ShouldBeNil)
+
+		chk := checker.Checker{
+			ZebedeeRoot:                tempZebedeeRoot,
+			CheckPublishedPreviousDays: 1,
+		}
+
+		// Override current time in checker package
+		checker.Now = func() time.Time {
+			return time.Date(2023, 2, 9, 13, 0, 0, 0, time.UTC)
+		}
+
+		Convey("When the published collections checker is run", func() {
+			valid, err := chk.CheckPublishedCollections(context.Background())
+
+			Convey("Then the fields that decode are still used", func() {
+				So(err, ShouldBeNil)
+				So(valid, ShouldBeTrue)
+			})
+		})
+	})
+}
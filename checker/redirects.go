@@ -0,0 +1,157 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// redirectTable is the file in master mapping legacy uris to the uris that replaced them
+const redirectTable = "redirect.txt"
+
+// CheckRedirects checks the redirect table of master. Every redirect should lead directly to a page in master, and
+// its legacy uri should no longer be a page itself, as the page would never be reached.
+func (c *Checker) CheckRedirects(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking redirect table of master")
+
+	tableURI := "/" + redirectTable
+	redirects, err := zebedee.GetRedirectsFromFile(c.masterPath(tableURI))
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Info(ctx, "no redirect table in master")
+			return true, nil
+		}
+		var syntaxErr *zebedee.RedirectSyntaxError
+		if errors.As(err, &syntaxErr) {
+			c.AddFinding(Finding{
+				Check:   CheckRedirects,
+				Path:    tableURI,
+				Message: fmt.Sprintf("redirect table in master could not be read: %s", syntaxErr),
+			})
+			return false, nil
+		}
+		return false, err
+	}
+
+	table := make(map[string]string)
+	for _, r := range redirects {
+		table[redirectURI(r.From)] = redirectURI(r.To)
+	}
+
+	valid := true
+	checked := make(map[string]bool)
+	for _, r := range redirects {
+		from := redirectURI(r.From)
+		if included, _ := c.Scope.includesURI(from); !included || checked[from] {
+			continue
+		}
+		checked[from] = true
+
+		redirectValid, err := c.checkRedirect(from, table)
+		if err != nil {
+			return false, err
+		}
+		valid = redirectValid && valid
+	}
+
+	if !valid {
+		log.Info(ctx, "redirect table of master is inconsistent", log.Data{"redirects": len(table)})
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkRedirect(from string, table map[string]string) (bool, error) {
+	valid := true
+
+	dead, err := c.isMasterPage(from)
+	if err != nil {
+		return false, err
+	}
+	if dead {
+		valid = false
+		c.AddFinding(Finding{
+			Check:   CheckRedirects,
+			Path:    from,
+			Message: fmt.Sprintf("redirect from '%s' is dead as the page still exists in master", from),
+		})
+	}
+
+	chain := []string{from}
+	to := table[from]
+	for {
+		if slices.Contains(chain, to) {
+			c.AddFinding(Finding{
+				Check:   CheckRedirects,
+				Path:    from,
+				Message: fmt.Sprintf("redirect from '%s' loops: %s", from, strings.Join(append(chain, to), " -> ")),
+			})
+			return false, nil
+		}
+		next, ok := table[to]
+		if !ok || isExternalURI(to) {
+			break
+		}
+		chain = append(chain, to)
+		to = next
+	}
+
+	if len(chain) > 1 {
+		// a missing page at the end of the chain is reported against the last redirect of the chain
+		c.AddFinding(Finding{
+			Check:   CheckRedirects,
+			Path:    from,
+			Message: fmt.Sprintf("redirect from '%s' chains through %d redirects: %s", from, len(chain), strings.Join(append(chain, to), " -> ")),
+		})
+		return false, nil
+	}
+
+	if isExternalURI(to) {
+		return valid, nil
+	}
+	exists, err := c.isMasterPage(to)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		valid = false
+		c.AddFinding(Finding{
+			Check:   CheckRedirects,
+			Path:    from,
+			Message: fmt.Sprintf("redirect from '%s' targets '%s' missing from master", from, to),
+		})
+	}
+	return valid, nil
+}
+
+// isMasterPage reports whether the uri, ignoring any query or fragment, is a page with a data.json in master
+func (c *Checker) isMasterPage(uri string) (bool, error) {
+	uri, _, _ = strings.Cut(uri, "#")
+	uri, _, _ = strings.Cut(uri, "?")
+	exists, isDir, err := c.existsInMaster(path.Join(uri, dataJSON))
+	return exists && !isDir, err
+}
+
+// redirectURI normalises a uri of the redirect table to the form of master uris, with a leading and no trailing slash
+func redirectURI(uri string) string {
+	if isExternalURI(uri) {
+		return uri
+	}
+	uri = strings.TrimSuffix(uri, "/")
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri
+	}
+	return uri
+}
+
+// isExternalURI reports whether a redirect leads away from the website, and so cannot be checked against master
+func isExternalURI(uri string) bool {
+	return strings.Contains(uri, "://") || strings.HasPrefix(uri, "//")
+}
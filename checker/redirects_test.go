@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckRedirects(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckRedirects, []checkTest{
+		{
+			given: "a master with a consistent redirect table",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/inflation")
+				So(addFile(root, "zebedee/master/redirect.txt", []byte(
+					"/economy/prices\t/economy/inflation\n"+
+						"\n"+
+						"economy/costs/\t/economy/inflation?tab=all\n"+
+						"/census\thttps://census.gov.uk\n")), ShouldBeNil)
+			},
+		},
+		{
+			given: "a master without a redirect table",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy")
+			},
+		},
+		{
+			given: "a master with an inconsistent redirect table",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/inflation", "zebedee/master/economy/prices")
+				So(addFile(root, "zebedee/master/redirect.txt", []byte(
+					"/economy/prices\t/economy/inflation\n"+
+						"/economy/gdp\t/economy/output\n"+
+						"/economy/costs\t/economy/prices\n"+
+						"/a\t/b\n"+
+						"/b\t/a\n")), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckRedirects,
+					Path:    "/economy/prices",
+					Message: "redirect from '/economy/prices' is dead as the page still exists in master",
+				},
+				{
+					Check:   checker.CheckRedirects,
+					Path:    "/economy/gdp",
+					Message: "redirect from '/economy/gdp' targets '/economy/output' missing from master",
+				},
+				{
+					Check: checker.CheckRedirects,
+					Path:  "/economy/costs",
+					Message: "redirect from '/economy/costs' chains through 2 redirects: " +
+						"/economy/costs -> /economy/prices -> /economy/inflation",
+				},
+				{
+					Check:   checker.CheckRedirects,
+					Path:    "/a",
+					Message: "redirect from '/a' loops: /a -> /b -> /a",
+				},
+				{
+					Check:   checker.CheckRedirects,
+					Path:    "/b",
+					Message: "redirect from '/b' loops: /b -> /a -> /b",
+				},
+			},
+		},
+		{
+			given: "a master with a malformed redirect table",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/inflation")
+				So(addFile(root, "zebedee/master/redirect.txt", []byte(
+					"/economy/prices\t/economy/inflation\n/economy/costs /economy/inflation\n")), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckRedirects,
+					Path:    "/redirect.txt",
+					Message: "redirect table in master could not be read: malformed redirect on line 2: \"/economy/costs /economy/inflation\"",
+				},
+			},
+		},
+	})
+}
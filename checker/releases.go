@@ -0,0 +1,169 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+const (
+	releasesURI     = "/releases"
+	releasePageType = "release"
+)
+
+// releaseLinkMarkup matches markdown links to release calendar pages, e.g. [CPI release](/releases/cpifebruary2023)
+var releaseLinkMarkup = regexp.MustCompile(`\]\((/releases/[^)\s?#]+)`)
+
+// CheckReleases checks the release calendar pages in master. Releases whose date has passed should be published,
+// published releases should link only to outputs in master, and cancelled releases should no longer be linked from
+// other pages.
+func (c *Checker) CheckReleases(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking release calendar of master")
+
+	now := Now()
+	valid := true
+	cancelled := make(map[string]bool)
+	linkedFrom := make(map[string][]string)
+
+	err := c.walkMasterPages(ctx, func(uri string, page *zebedee.Page) error {
+		for _, release := range releaseLinks(uri, page) {
+			if !slices.Contains(linkedFrom[release], uri) {
+				linkedFrom[release] = append(linkedFrom[release], uri)
+			}
+		}
+
+		if page.Type != releasePageType || !strings.HasPrefix(uri, releasesURI+"/") {
+			return nil
+		}
+		if page.Description.Cancelled {
+			cancelled[uri] = true
+			return nil
+		}
+		releaseValid, err := c.checkRelease(ctx, uri, page, now)
+		if err != nil {
+			return err
+		}
+		valid = releaseValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, release := range slices.Sorted(maps.Keys(cancelled)) {
+		for _, uri := range linkedFrom[release] {
+			valid = false
+			c.AddFinding(Finding{
+				Check:   CheckReleases,
+				Path:    uri,
+				Message: fmt.Sprintf("cancelled release '%s' still linked from page '%s'", release, uri),
+			})
+		}
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkRelease(ctx context.Context, uri string, page *zebedee.Page, now time.Time) (bool, error) {
+	if !page.Description.Published {
+		releaseDate, err := time.Parse(time.RFC3339, page.Description.ReleaseDate)
+		if err != nil {
+			log.Info(ctx, "unpublished release has invalid release date", log.Data{
+				"uri":          uri,
+				"release_date": page.Description.ReleaseDate,
+			})
+			c.AddFinding(Finding{
+				Check:   CheckReleases,
+				Path:    uri,
+				Message: fmt.Sprintf("release '%s' has invalid release date '%s'", uri, page.Description.ReleaseDate),
+			})
+			return false, nil
+		}
+		if releaseDate.Before(now) {
+			c.AddFinding(Finding{
+				Check:   CheckReleases,
+				Path:    uri,
+				Message: fmt.Sprintf("release '%s' due on %s not marked as published", uri, releaseDate.UTC().Format(time.RFC3339)),
+			})
+			return false, nil
+		}
+		return true, nil
+	}
+
+	valid := true
+	for _, output := range releaseOutputs(uri, page) {
+		exists, err := c.isMasterPage(output)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			valid = false
+			c.AddFinding(Finding{
+				Check:   CheckReleases,
+				Path:    output,
+				Message: fmt.Sprintf("published release '%s' links to output '%s' missing from master", uri, output),
+			})
+		}
+	}
+	return valid, nil
+}
+
+// releaseOutputs returns the distinct uris of the outputs of a release
+func releaseOutputs(uri string, page *zebedee.Page) []string {
+	outputs := make([]string, 0)
+	for _, links := range [][]zebedee.Link{
+		page.RelatedDocuments,
+		page.RelatedDatasets,
+		page.RelatedMethodology,
+		page.RelatedMethodologyArticle,
+	} {
+		for _, l := range links {
+			output := resolveURI(uri, l.URI)
+			if l.URI != "" && !slices.Contains(outputs, output) {
+				outputs = append(outputs, output)
+			}
+		}
+	}
+	return outputs
+}
+
+// releaseLinks returns the uris of release calendar pages linked from a page, whether in its link lists or its section
+// markdown
+func releaseLinks(uri string, page *zebedee.Page) []string {
+	links := make([]string, 0)
+	for _, list := range [][]zebedee.Link{
+		page.Links,
+		page.RelatedDocuments,
+		page.RelatedDatasets,
+		page.RelatedMethodology,
+		page.RelatedMethodologyArticle,
+	} {
+		for _, l := range list {
+			if l.URI != "" {
+				links = append(links, resolveURI(uri, l.URI))
+			}
+		}
+	}
+	for _, sections := range [][]zebedee.Section{page.Sections, page.Accordion} {
+		for _, s := range sections {
+			for _, m := range releaseLinkMarkup.FindAllStringSubmatch(s.Markdown, -1) {
+				links = append(links, resolveURI(uri, m[1]))
+			}
+		}
+	}
+
+	releases := make([]string, 0)
+	for _, l := range links {
+		if strings.HasPrefix(l, releasesURI+"/") && l != uri {
+			releases = append(releases, l)
+		}
+	}
+	return releases
+}
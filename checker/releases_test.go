@@ -0,0 +1,77 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckReleases(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return time.Date(2023, 2, 15, 9, 0, 0, 0, time.UTC)
+	}
+
+	runCheckTests(t, checker.CheckReleases, []checkTest{
+		{
+			given: "a master with a consistent release calendar",
+			setup: func(root string) {
+				addPages(root, "zebedee/master/economy/inflation/bulletins/cpi/2023-02-15")
+				So(addFile(root, "zebedee/master/releases/cpifebruary2023/data.json", []byte(`{"type": "release",
+					"description": {"releaseDate": "2023-02-15T07:00:00.000Z", "published": true},
+					"relatedDocuments": [{"uri": "/economy/inflation/bulletins/cpi/2023-02-15"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/releases/cpimarch2023/data.json", []byte(`{"type": "release",
+					"description": {"releaseDate": "2023-03-22T07:00:00.000Z", "published": false}
+				}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "a master with an inconsistent release calendar",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/releases/cpifebruary2023/data.json", []byte(`{"type": "release",
+					"description": {"releaseDate": "2023-02-15T07:00:00.000Z", "published": true},
+					"relatedDatasets": [{"uri": "/economy/inflation/datasets/mm23"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/releases/gdpfebruary2023/data.json", []byte(`{"type": "release",
+					"description": {"releaseDate": "2023-02-14T07:00:00.000Z", "published": false}
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/releases/labourmarketfebruary2023/data.json", []byte(`{"type": "release",
+					"description": {"releaseDate": "2023-02-14T07:00:00.000Z", "cancelled": true}
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/employment/bulletins/lms/2023-02-14/data.json", []byte(`{
+					"type": "bulletin",
+					"sections": [{"markdown": "See the [release](/releases/labourmarketfebruary2023) for details"}]
+				}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check: checker.CheckReleases,
+					Path:  "/economy/inflation/datasets/mm23",
+					Message: "published release '/releases/cpifebruary2023' links to output " +
+						"'/economy/inflation/datasets/mm23' missing from master",
+				},
+				{
+					Check:   checker.CheckReleases,
+					Path:    "/releases/gdpfebruary2023",
+					Message: "release '/releases/gdpfebruary2023' due on 2023-02-14T07:00:00Z not marked as published",
+				},
+				{
+					Check: checker.CheckReleases,
+					Path:  "/employment/bulletins/lms/2023-02-14",
+					Message: "cancelled release '/releases/labourmarketfebruary2023' still linked from page " +
+						"'/employment/bulletins/lms/2023-02-14'",
+				},
+			},
+		},
+	})
+}
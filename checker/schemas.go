@@ -0,0 +1,111 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/ONSdigital/dp-integrity-checker/schema"
+)
+
+// CheckSchemas validates the data.json of every page in master against the schema of its page type, from the schema
+// set of SchemaVersion with any overrides in SchemaDir. Empty data.json files and those without a page type are
+// reported, and pages of types without a schema are not validated.
+func (c *Checker) CheckSchemas(ctx context.Context) (bool, error) {
+	set, err := schema.Load(c.SchemaVersion, c.SchemaDir)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to load schemas")
+	}
+	log.Info(ctx, "checking pages in master against schemas", log.Data{"version": set.Version, "dir": c.SchemaDir})
+
+	valid := true
+	err = c.walkMaster(ctx, func(uri string, d fs.DirEntry) error {
+		if d.IsDir() {
+			if d.Name() == previousDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() != dataJSON {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() == 0 {
+			valid = false
+			pageURI := path.Dir(uri)
+			c.AddFinding(Finding{
+				Check:   CheckSchemas,
+				Path:    pageURI,
+				Message: fmt.Sprintf("data.json of page '%s' is empty", pageURI),
+			})
+			return nil
+		}
+
+		pageValid, err := c.checkPageSchema(uri, set)
+		if err != nil {
+			return err
+		}
+		valid = pageValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+func (c *Checker) checkPageSchema(uri string, set *schema.Set) (bool, error) {
+	pageURI := path.Dir(uri)
+
+	f, err := os.Open(c.masterPath(uri))
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	doc, err := jsonschema.UnmarshalJSON(f)
+	if err != nil {
+		c.AddFinding(Finding{
+			Check:   CheckSchemas,
+			Path:    pageURI,
+			Message: fmt.Sprintf("data.json of page '%s' is not valid JSON", pageURI),
+		})
+		return false, nil
+	}
+
+	obj, _ := doc.(map[string]any)
+	pageType, _ := obj["type"].(string)
+	if pageType == "" {
+		c.AddFinding(Finding{
+			Check:   CheckSchemas,
+			Path:    rulePath(uri, "/type"),
+			Message: fmt.Sprintf("data.json of page '%s' has no page type", pageURI),
+		})
+		return false, nil
+	}
+	violations, ok := set.Validate(pageType, doc)
+	if !ok {
+		return true, nil
+	}
+
+	for _, v := range violations {
+		c.AddFinding(Finding{
+			Check: CheckSchemas,
+			Path:  rulePath(uri, v.Field+":"+v.Rule),
+			Message: fmt.Sprintf("%s '%s' violates rule '%s' of schema %s at '%s': %s",
+				pageType, pageURI, v.Rule, set.Version, v.Field, v.Message),
+		})
+	}
+	return len(violations) == 0, nil
+}
@@ -0,0 +1,114 @@
+package checker_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckSchemas(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckSchemas, []checkTest{
+		{
+			given: "a master with pages valid against their schemas",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{
+					"type": "bulletin", "description": {"title": "B1", "releaseDate": "2023-02-15T07:00:00.000Z"}
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/bulletins/b1/previous/v1/data.json", []byte(`{"type": "bulletin"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/surveys/s1/data.json", []byte(`{"type": "survey_page"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/data.json", []byte(`{"type": "taxonomy_landing_page", "description": {"title": "Economy"}}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "a master with pages violating their schemas",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/bulletins/b1/data.json", []byte(`{
+					"type": "bulletin", "description": {"title": ""}, "charts": {}
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/timeseries/d7bt/data.json", []byte(`{"type": "timeseries"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/articles/a1/data.json", []byte(`{"type": "article",`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/articles/a2/data.json", nil), ShouldBeNil)
+				So(addFile(root, "zebedee/master/articles/a3/data.json", []byte(`{"description": {"title": "A3"}}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/articles/a4/data.json", []byte(`{"type": 1}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/articles/a1",
+					Message: "data.json of page '/articles/a1' is not valid JSON",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/articles/a2",
+					Message: "data.json of page '/articles/a2' is empty",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/articles/a3/data.json#/type",
+					Message: "data.json of page '/articles/a3' has no page type",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/articles/a4/data.json#/type",
+					Message: "data.json of page '/articles/a4' has no page type",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/bulletins/b1/data.json#/charts:type",
+					Message: "bulletin '/bulletins/b1' violates rule 'type' of schema v1 at '/charts': got object, want array",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/bulletins/b1/data.json#/description/title:minLength",
+					Message: "bulletin '/bulletins/b1' violates rule 'minLength' of schema v1 at '/description/title': minLength: got 0, want 1",
+				},
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/timeseries/d7bt/data.json#/description:required",
+					Message: "timeseries '/timeseries/d7bt' violates rule 'required' of schema v1 at '/description': field missing",
+				},
+			},
+		},
+		{
+			given: "a dir of schemas overriding the bundled set",
+			setup: func(root string) {
+				So(addFile(root, "zebedee/master/surveys/s1/data.json", []byte(`{"type": "survey_page"}`)), ShouldBeNil)
+				So(addFile(root, "schemas/survey_page.json", []byte(`{"required": ["description"]}`)), ShouldBeNil)
+			},
+			configure: func(chk *checker.Checker) {
+				chk.SchemaDir = path.Join(chk.ZebedeeRoot, "schemas")
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckSchemas,
+					Path:    "/surveys/s1/data.json#/description:required",
+					Message: "survey_page '/surveys/s1' violates rule 'required' of schema v1 at '/description': field missing",
+				},
+			},
+		},
+	})
+
+	Convey("Given an unknown schema version", t, func() {
+		root := newZebedeeRoot()
+		addPages(root, "zebedee/master/economy")
+		chk := checker.Checker{ZebedeeRoot: root, SchemaVersion: "v0"}
+
+		Convey("When the schemas checker is run", func() {
+			_, err := chk.CheckSchemas(context.Background())
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
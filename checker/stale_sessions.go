@@ -0,0 +1,113 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+// stores of the sessions and application keys in the zebedee root
+const (
+	sessionsDir        = "zebedee/sessions"
+	applicationKeysDir = "zebedee/application-keys"
+)
+
+// defaultStaleAfter is how long sessions and application keys may go unused before being reported, unless StaleAfter
+// is set
+const defaultStaleAfter = 30 * 24 * time.Hour
+
+// CheckStaleSessions reports sessions and application keys that have gone unused for longer than StaleAfter, judged
+// by when their files were last modified, as informational findings to inform clean up. Sessions are named by their
+// token, so only their count and ages are reported.
+func (c *Checker) CheckStaleSessions(ctx context.Context) (bool, error) {
+	staleAfter := c.StaleAfter
+	if staleAfter <= 0 {
+		staleAfter = defaultStaleAfter
+	}
+	log.Info(ctx, "checking for stale sessions and application keys", log.Data{"stale_after": staleAfter.String()})
+
+	now := Now()
+	valid := true
+
+	sessions, err := c.fileAges(sessionsDir, now)
+	if err != nil {
+		return false, err
+	}
+	stale, oldest := 0, time.Duration(0)
+	for _, age := range sessions {
+		if age > staleAfter {
+			stale++
+			oldest = max(oldest, age)
+		}
+	}
+	log.Info(ctx, "counted sessions", log.Data{"sessions": len(sessions), "stale": stale})
+	if stale > 0 {
+		valid = false
+		c.AddFinding(Finding{
+			Check:    CheckStaleSessions,
+			Path:     sessionsDir,
+			Message:  fmt.Sprintf("%d of %d sessions unused for over %s, the oldest for %s", stale, len(sessions), days(staleAfter), days(oldest)),
+			Severity: SeverityInfo,
+		})
+	}
+
+	keys, err := c.fileAges(applicationKeysDir, now)
+	if err != nil {
+		return false, err
+	}
+	log.Info(ctx, "counted application keys", log.Data{"application_keys": len(keys)})
+	for _, name := range slices.Sorted(maps.Keys(keys)) {
+		if age := keys[name]; age > staleAfter {
+			valid = false
+			c.AddFinding(Finding{
+				Check:    CheckStaleSessions,
+				Path:     path.Join(applicationKeysDir, name),
+				Message:  fmt.Sprintf("application key '%s' unused for %s", name, days(age)),
+				Severity: SeverityInfo,
+			})
+		}
+	}
+
+	return valid, nil
+}
+
+// fileAges returns how long ago each file in a dir of the zebedee root was last modified, keyed by file name. A missing
+// dir has no files.
+func (c *Checker) fileAges(dir string, now time.Time) (map[string]time.Duration, error) {
+	entries, err := os.ReadDir(path.Join(c.ZebedeeRoot, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]time.Duration{}, nil
+		}
+		return nil, errors.Wrapf(err, "unable to read '%s'", dir)
+	}
+
+	ages := make(map[string]time.Duration)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to stat file in '%s'", dir)
+		}
+		ages[e.Name()] = now.Sub(info.ModTime())
+	}
+	return ages, nil
+}
+
+// days formats a duration in whole days
+func days(d time.Duration) string {
+	n := int(d / (24 * time.Hour))
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
@@ -0,0 +1,93 @@
+package checker_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckStaleSessions(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	now := time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+	// Override current time in checker package
+	checker.Now = func() time.Time {
+		return now
+	}
+
+	addAgedFile := func(ws, fpath string, age time.Duration) {
+		So(addFile(ws, fpath, []byte("x")), ShouldBeNil)
+		modTime := now.Add(-age)
+		So(os.Chtimes(path.Join(ws, fpath), modTime, modTime), ShouldBeNil)
+	}
+
+	addStaleSessions := func(root string) {
+		addAgedFile(root, "zebedee/sessions/3f2a9c.json", time.Hour)
+		addAgedFile(root, "zebedee/sessions/7b1e04.json", 40*24*time.Hour)
+		addAgedFile(root, "zebedee/sessions/c9d5e1.json", 90*24*time.Hour)
+		addAgedFile(root, "zebedee/application-keys/zebedee-reader", 24*time.Hour)
+		addAgedFile(root, "zebedee/application-keys/legacy-app", 400*24*time.Hour)
+	}
+
+	runCheckTests(t, checker.CheckStaleSessions, []checkTest{
+		{
+			given: "stale sessions and application keys",
+			setup: addStaleSessions,
+			configure: func(chk *checker.Checker) {
+				chk.StaleAfter = 30 * 24 * time.Hour
+			},
+			findings: []checker.Finding{
+				{
+					Check:    checker.CheckStaleSessions,
+					Path:     "zebedee/sessions",
+					Message:  "2 of 3 sessions unused for over 30 days, the oldest for 90 days",
+					Severity: checker.SeverityInfo,
+				},
+				{
+					Check:    checker.CheckStaleSessions,
+					Path:     "zebedee/application-keys/legacy-app",
+					Message:  "application key 'legacy-app' unused for 400 days",
+					Severity: checker.SeverityInfo,
+				},
+			},
+		},
+	})
+
+	Convey("Given stale sessions and application keys", t, func() {
+		root := newZebedeeRoot()
+		addStaleSessions(root)
+
+		Convey("When the checker is run without selecting checks", func() {
+			chk := checker.Checker{ZebedeeRoot: root}
+			res, err := chk.Run(context.Background())
+
+			Convey("Then the informational check is not run", func() {
+				So(err, ShouldBeNil)
+				So(res.Success, ShouldBeTrue)
+				So(res.Findings, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given an unknown check is selected", t, func() {
+		chk := checker.Checker{ZebedeeRoot: newZebedeeRoot(), Checks: []string{"stale-cookies"}}
+
+		Convey("When the checker is run", func() {
+			res, err := chk.Run(context.Background())
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(res, ShouldBeNil)
+			})
+		})
+	})
+}
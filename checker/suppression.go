@@ -0,0 +1,156 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Suppression accepts a known inconsistency until it expires. A finding is suppressed if it matches every one of the
+// fingerprint, path glob and collection that are set. A path glob ending in '/**' matches everything below it, and a
+// path glob matches a path whether or not it is qualified by a rule.
+type Suppression struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Collection  string `json:"collection,omitempty"`
+	Expires     string `json:"expires"`
+	Reason      string `json:"reason"`
+	expires     time.Time
+}
+
+// SuppressedFinding is a finding that was accepted by a suppression rather than reported
+type SuppressedFinding struct {
+	Finding
+	Reason  string `json:"reason"`
+	Expires string `json:"expires"`
+}
+
+type suppressionFile struct {
+	Suppressions []Suppression `json:"suppressions"`
+}
+
+// LoadSuppressions reads and validates a JSON suppression file
+func LoadSuppressions(filename string) ([]Suppression, error) {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read suppression file")
+	}
+
+	var file suppressionFile
+	if err = json.Unmarshal(body, &file); err != nil {
+		return nil, errors.Wrap(err, "invalid suppression file")
+	}
+
+	for i := range file.Suppressions {
+		s := &file.Suppressions[i]
+		if s.Fingerprint == "" && s.Path == "" && s.Collection == "" {
+			return nil, errors.Errorf("suppression %d must set at least one of fingerprint, path or collection", i)
+		}
+		if s.Reason == "" {
+			return nil, errors.Errorf("suppression %d must give a reason", i)
+		}
+		if s.expires, err = time.Parse("2006-01-02", s.Expires); err != nil {
+			return nil, errors.Errorf("suppression %d must expire on a date in the format 2006-01-02", i)
+		}
+		if s.Path != "" {
+			if _, err = path.Match(strings.TrimSuffix(s.Path, "/**"), ""); err != nil {
+				return nil, errors.Wrapf(err, "suppression %d has an invalid path glob", i)
+			}
+		}
+	}
+	return file.Suppressions, nil
+}
+
+// Expired reports whether the suppression no longer applies at the time given
+func (s Suppression) Expired(at time.Time) bool {
+	return !at.Before(s.expires.AddDate(0, 0, 1))
+}
+
+// Matches reports whether the finding is accepted by the suppression, regardless of expiry
+func (s Suppression) Matches(f Finding) bool {
+	if s.Fingerprint != "" && s.Fingerprint != f.Fingerprint() {
+		return false
+	}
+	if s.Collection != "" && !(Scope{Collection: s.Collection}).includesCollection(f.Collection) {
+		return false
+	}
+	if s.Path != "" && !matchPath(s.Path, f.Path) {
+		return false
+	}
+	return true
+}
+
+// String describes what the suppression matches
+func (s Suppression) String() string {
+	parts := make([]string, 0, 3)
+	if s.Fingerprint != "" {
+		parts = append(parts, "fingerprint="+s.Fingerprint)
+	}
+	if s.Path != "" {
+		parts = append(parts, "path="+s.Path)
+	}
+	if s.Collection != "" {
+		parts = append(parts, "collection="+s.Collection)
+	}
+	return strings.Join(parts, " ")
+}
+
+// matchPath reports whether the path of a finding matches the glob, with or without the rule qualifying it
+func matchPath(glob, p string) bool {
+	if unqualified, _, ok := strings.Cut(p, "#"); ok && matchPath(glob, unqualified) {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(glob, "/**"); ok {
+		if p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+		glob = prefix + "/*"
+	}
+	matched, _ := path.Match(glob, p) // pattern validated on load
+	return matched
+}
+
+// applySuppressions moves findings accepted by an unexpired suppression out of the reported findings, and reports a
+// finding for each suppression that has expired, at the path glob and collection it matches
+func (c *Checker) applySuppressions(ctx context.Context, suppressions []Suppression) {
+	now := Now()
+
+	c.mu.Lock()
+	findings := make([]Finding, 0, len(c.findings))
+	for _, f := range c.findings {
+		suppressed := false
+		for _, s := range suppressions {
+			if !s.Expired(now) && s.Matches(f) {
+				c.suppressed = append(c.suppressed, SuppressedFinding{Finding: f, Reason: s.Reason, Expires: s.Expires})
+				suppressed = true
+				break
+			}
+		}
+		if !suppressed {
+			findings = append(findings, f)
+		}
+	}
+	if c.findings != nil {
+		c.findings = findings
+	}
+	c.mu.Unlock()
+
+	for _, s := range suppressions {
+		if s.Expired(now) {
+			log.Info(ctx, "suppression has expired", log.Data{"suppression": s.String(), "expires": s.Expires})
+			c.AddFinding(Finding{
+				Check:      CheckSuppressions,
+				Collection: s.Collection,
+				Path:       s.Path,
+				Message:    fmt.Sprintf("suppression '%s' expired on %s: %s", s.String(), s.Expires, s.Reason),
+			})
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package checker_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestLoadSuppressions(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a temporary directory for suppression files", t, func() {
+		tempDir, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		Convey("When a valid suppression file is loaded", func() {
+			err = addFile(tempDir, "suppressions.json", []byte(`{"suppressions": [
+				{"path": "/legacy/**", "expires": "2023-03-01", "reason": "removed by hand"},
+				{"collection": "col1", "fingerprint": "abc", "expires": "2023-03-01", "reason": "known"}
+			]}`))
+			So(err, ShouldBeNil)
+			suppressions, err := checker.LoadSuppressions(path.Join(tempDir, "suppressions.json"))
+
+			Convey("Then the suppressions are returned", func() {
+				So(err, ShouldBeNil)
+				So(suppressions, ShouldHaveLength, 2)
+				So(suppressions[0].String(), ShouldEqual, "path=/legacy/**")
+				So(suppressions[1].String(), ShouldEqual, "fingerprint=abc collection=col1")
+			})
+		})
+
+		Convey("When a suppression without a matcher is loaded", func() {
+			err = addFile(tempDir, "suppressions.json", []byte(`{"suppressions": [
+				{"expires": "2023-03-01", "reason": "everything"}
+			]}`))
+			So(err, ShouldBeNil)
+			_, err := checker.LoadSuppressions(path.Join(tempDir, "suppressions.json"))
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "suppression 0 must set at least one of fingerprint, path or collection")
+			})
+		})
+
+		Convey("When a suppression without a reason is loaded", func() {
+			err = addFile(tempDir, "suppressions.json", []byte(`{"suppressions": [
+				{"path": "/legacy", "expires": "2023-03-01"}
+			]}`))
+			So(err, ShouldBeNil)
+			_, err := checker.LoadSuppressions(path.Join(tempDir, "suppressions.json"))
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "suppression 0 must give a reason")
+			})
+		})
+
+		Convey("When a suppression with an invalid expiry is loaded", func() {
+			err = addFile(tempDir, "suppressions.json", []byte(`{"suppressions": [
+				{"path": "/legacy", "expires": "never", "reason": "forever"}
+			]}`))
+			So(err, ShouldBeNil)
+			_, err := checker.LoadSuppressions(path.Join(tempDir, "suppressions.json"))
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "suppression 0 must expire on a date in the format 2006-01-02")
+			})
+		})
+	})
+}
+
+func TestSuppressionMatches(t *testing.T) {
+	Convey("Given a finding for a missing dir in a published collection", t, func() {
+		f := checker.Finding{
+			Check:      checker.CheckPublishedDirs,
+			Collection: "2023-02-09-12-13-collection2",
+			Path:       "/legacy/somepage/v2",
+		}
+
+		Convey("Then it is matched by its fingerprint", func() {
+			So(checker.Suppression{Fingerprint: f.Fingerprint()}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Fingerprint: "abc"}.Matches(f), ShouldBeFalse)
+		})
+
+		Convey("Then it is matched by its collection dir or name", func() {
+			So(checker.Suppression{Collection: "2023-02-09-12-13-collection2"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Collection: "collection2"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Collection: "collection1"}.Matches(f), ShouldBeFalse)
+		})
+
+		Convey("Then it is matched by path globs", func() {
+			So(checker.Suppression{Path: "/legacy/*/v2"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Path: "/legacy/**"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Path: "/legacy/*"}.Matches(f), ShouldBeFalse)
+			So(checker.Suppression{Path: "/current/**"}.Matches(f), ShouldBeFalse)
+		})
+
+		Convey("Then every matcher set must match", func() {
+			So(checker.Suppression{Path: "/legacy/**", Collection: "collection1"}.Matches(f), ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a finding with its path qualified by the rule it breaks", t, func() {
+		f := checker.Finding{Check: checker.CheckPermissions, Path: "/legacy/somepage/data.json#world-writable"}
+
+		Convey("Then it is matched by path globs with or without the rule", func() {
+			So(checker.Suppression{Path: "/legacy/somepage/data.json"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Path: "/legacy/somepage/data.json#world-writable"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Path: "/legacy/**"}.Matches(f), ShouldBeTrue)
+			So(checker.Suppression{Path: "/legacy/somepage/data.json#not-writable"}.Matches(f), ShouldBeFalse)
+		})
+	})
+}
+
+func TestRun_Suppressions(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a workspace with a published dir missing from master", t, func() {
+		tempZebedeeRoot, err := os.MkdirTemp("", "checkertest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempZebedeeRoot)
+
+		addDirs(tempZebedeeRoot,
+			"zebedee/master/somepage/v1",
+			"zebedee/publish-log/2023-02-09-10-13-collection2/somepage/v1",
+			"zebedee/publish-log/2023-02-09-10-13-collection2/somepage/v2",
+		)
+		err = addFile(tempZebedeeRoot, "zebedee/publish-log/2023-02-09-10-13-collection2.json", []byte("{}"))
+		So(err, ShouldBeNil)
+
+		// Override current time in checker package
+		checker.Now = func() time.Time {
+			return time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+		}
+
+		chk := checker.Checker{
+			ZebedeeRoot:      tempZebedeeRoot,
+			SuppressionsPath: path.Join(tempZebedeeRoot, "suppressions.json"),
+		}
+
+		Convey("When the checker is run with a suppression for the missing dir", func() {
+			err = addFile(tempZebedeeRoot, "suppressions.json", []byte(`{"suppressions": [
+				{"path": "/somepage/**", "expires": "2023-02-09", "reason": "removed by hand"}
+			]}`))
+			So(err, ShouldBeNil)
+			res, err := chk.Run(context.Background())
+
+			Convey("Then the run succeeds with the finding reported as suppressed", func() {
+				So(err, ShouldBeNil)
+				So(res.Success, ShouldBeTrue)
+				So(res.Findings, ShouldBeEmpty)
+				So(res.Inconsistencies, ShouldBeEmpty)
+				So(res.Suppressed, ShouldHaveLength, 1)
+				So(res.Suppressed[0].Path, ShouldEqual, "/somepage/v2")
+				So(res.Suppressed[0].Reason, ShouldEqual, "removed by hand")
+			})
+		})
+
+		Convey("When the checker is run with an expired suppression for the missing dir", func() {
+			err = addFile(tempZebedeeRoot, "suppressions.json", []byte(`{"suppressions": [
+				{"path": "/somepage/**", "expires": "2023-02-08", "reason": "removed by hand"}
+			]}`))
+			So(err, ShouldBeNil)
+			res, err := chk.Run(context.Background())
+
+			Convey("Then the run fails with both the missing dir and the expired suppression reported", func() {
+				So(err, ShouldBeNil)
+				So(res.Success, ShouldBeFalse)
+				So(res.Suppressed, ShouldBeEmpty)
+				So(res.Findings, ShouldHaveLength, 2)
+				So(res.Findings[0].Check, ShouldEqual, checker.CheckPublishedDirs)
+				So(res.Findings[1].Check, ShouldEqual, checker.CheckSuppressions)
+				So(res.Findings[1].Path, ShouldEqual, "/somepage/**")
+				So(res.Findings[1].Message, ShouldEqual,
+					"suppression 'path=/somepage/**' expired on 2023-02-08: removed by hand")
+			})
+		})
+
+		Convey("When the checker is run with an invalid suppression file", func() {
+			err = addFile(tempZebedeeRoot, "suppressions.json", []byte(`not json`))
+			So(err, ShouldBeNil)
+			res, err := chk.Run(context.Background())
+
+			Convey("Then an error is returned", func() {
+				So(res, ShouldBeNil)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "invalid suppression file")
+			})
+		})
+	})
+}
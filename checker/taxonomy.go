@@ -0,0 +1,211 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// taxonomyPageTypes are the page types forming the nodes of the taxonomy tree
+var taxonomyPageTypes = map[string]bool{
+	"home_page":             true,
+	"taxonomy_landing_page": true,
+	"product_page":          true,
+}
+
+// listedPageTypes are the page types that must be listed as a child by their parent taxonomy node
+var listedPageTypes = map[string]bool{
+	"taxonomy_landing_page": true,
+	"product_page":          true,
+}
+
+// taxonomy looks up the taxonomy nodes of master as they are needed, caching each lookup. A nil node records that the
+// uri is not a taxonomy node.
+type taxonomy struct {
+	c     *Checker
+	nodes map[string]*zebedee.Page
+}
+
+// CheckTaxonomy checks the taxonomy tree of master. The breadcrumb of every page should list the taxonomy nodes above
+// it, the children of each node should exist, and landing and product pages should be listed by their parent node.
+func (c *Checker) CheckTaxonomy(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking taxonomy and breadcrumbs of master")
+
+	t := &taxonomy{c: c, nodes: make(map[string]*zebedee.Page)}
+	valid := true
+	err := c.walkMasterPages(ctx, func(uri string, page *zebedee.Page) error {
+		pageValid, err := t.checkPage(uri, page)
+		if err != nil {
+			return err
+		}
+		if !pageValid {
+			log.Info(ctx, "page is inconsistent with the taxonomy", log.Data{"uri": uri})
+		}
+		valid = pageValid && valid
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+func (t *taxonomy) checkPage(uri string, page *zebedee.Page) (bool, error) {
+	valid := true
+
+	if taxonomyPageTypes[page.Type] {
+		for _, child := range taxonomyChildren(uri, page) {
+			exists, err := t.c.isMasterPage(child)
+			if err != nil {
+				return false, err
+			}
+			if !exists {
+				valid = false
+				t.c.AddFinding(Finding{
+					Check:   CheckTaxonomy,
+					Path:    child,
+					Message: fmt.Sprintf("taxonomy node '%s' references child '%s' missing from master", uri, child),
+				})
+			}
+		}
+	}
+
+	if len(page.Breadcrumb) > 0 {
+		breadcrumbValid, err := t.checkBreadcrumb(uri, page)
+		if err != nil {
+			return false, err
+		}
+		valid = breadcrumbValid && valid
+	}
+
+	if listedPageTypes[page.Type] {
+		ancestry, err := t.ancestry(uri)
+		if err != nil {
+			return false, err
+		}
+		if len(ancestry) > 0 {
+			parentURI := ancestry[len(ancestry)-1]
+			if !slices.Contains(taxonomyChildren(parentURI, t.nodes[parentURI]), uri) {
+				valid = false
+				t.c.AddFinding(Finding{
+					Check:   CheckTaxonomy,
+					Path:    uri,
+					Message: fmt.Sprintf("%s '%s' not listed by its parent taxonomy node '%s'", page.Type, uri, parentURI),
+				})
+			}
+		}
+	}
+
+	return valid, nil
+}
+
+// checkBreadcrumb checks that every breadcrumb of the page is a taxonomy node and that together they match the taxonomy
+// nodes above the page in master. A breadcrumb that is broken is not also reported as not matching.
+func (t *taxonomy) checkBreadcrumb(uri string, page *zebedee.Page) (bool, error) {
+	breadcrumb := make([]string, 0, len(page.Breadcrumb))
+	problems := make([]string, 0)
+	for _, b := range page.Breadcrumb {
+		crumb := resolveURI(uri, b.URI)
+		breadcrumb = append(breadcrumb, crumb)
+
+		node, err := t.node(crumb)
+		if err != nil {
+			return false, err
+		}
+		if node != nil {
+			continue
+		}
+		exists, err := t.c.isMasterPage(crumb)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			problems = append(problems, fmt.Sprintf("'%s' is not a taxonomy node", crumb))
+		} else {
+			problems = append(problems, fmt.Sprintf("'%s' missing from master", crumb))
+		}
+	}
+	if len(problems) > 0 {
+		t.c.AddFinding(Finding{
+			Check:   CheckTaxonomy,
+			Path:    uri,
+			Message: fmt.Sprintf("breadcrumb of page '%s' is broken: %s", uri, strings.Join(problems, ", ")),
+		})
+		return false, nil
+	}
+
+	ancestry, err := t.ancestry(uri)
+	if err != nil {
+		return false, err
+	}
+	if !slices.Equal(breadcrumb, ancestry) {
+		t.c.AddFinding(Finding{
+			Check: CheckTaxonomy,
+			Path:  uri,
+			Message: fmt.Sprintf("breadcrumb of page '%s' does not match its ancestry: breadcrumb [%s], taxonomy nodes above [%s]",
+				uri, strings.Join(breadcrumb, ", "), strings.Join(ancestry, ", ")),
+		})
+		return false, nil
+	}
+	return true, nil
+}
+
+// node returns the taxonomy node at the uri, or nil if the uri is not a taxonomy node in master
+func (t *taxonomy) node(uri string) (*zebedee.Page, error) {
+	if node, ok := t.nodes[uri]; ok {
+		return node, nil
+	}
+
+	node, err := zebedee.GetPageFromFile(t.c.masterPath(path.Join(uri, dataJSON)))
+	if err != nil {
+		if !os.IsNotExist(err) && !isDecodeError(err) {
+			return nil, err
+		}
+		node = nil
+	}
+	if node != nil && !taxonomyPageTypes[node.Type] {
+		node = nil
+	}
+	t.nodes[uri] = node
+	return node, nil
+}
+
+// ancestry returns the uris of the taxonomy nodes above the uri in master, starting from the home page
+func (t *taxonomy) ancestry(uri string) ([]string, error) {
+	ancestry := make([]string, 0)
+	for p := uri; p != "/"; {
+		p = path.Dir(p)
+		node, err := t.node(p)
+		if err != nil {
+			return nil, err
+		}
+		if node != nil {
+			ancestry = append(ancestry, p)
+		}
+	}
+	slices.Reverse(ancestry)
+	return ancestry, nil
+}
+
+// taxonomyChildren returns the uris of the child pages listed by a taxonomy node in its sections and items
+func taxonomyChildren(uri string, node *zebedee.Page) []string {
+	children := make([]string, 0)
+	for _, s := range node.Sections {
+		if s.Theme.URI != "" {
+			children = append(children, resolveURI(uri, s.Theme.URI))
+		}
+	}
+	for _, item := range node.Items {
+		if item.URI != "" {
+			children = append(children, resolveURI(uri, item.URI))
+		}
+	}
+	return children
+}
@@ -0,0 +1,89 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckTaxonomy(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	addTaxonomy := func(ws string) {
+		So(addFile(ws, "zebedee/master/data.json", []byte(`{"type": "home_page", "sections": [
+			{"theme": {"uri": "/economy"}}
+		]}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/master/economy/data.json", []byte(`{"type": "taxonomy_landing_page",
+			"breadcrumb": [{"uri": "/"}],
+			"sections": [{"theme": {"uri": "/economy/inflation"}}]
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/master/economy/inflation/data.json", []byte(`{"type": "product_page",
+			"breadcrumb": [{"uri": "/"}, {"uri": "/economy"}],
+			"items": [{"uri": "/economy/inflation/timeseries/d7bt"}]
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/master/economy/inflation/timeseries/d7bt/data.json", []byte(`{"type": "timeseries",
+			"breadcrumb": [{"uri": "/"}, {"uri": "/economy"}, {"uri": "/economy/inflation"}]
+		}`)), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckTaxonomy, []checkTest{
+		{
+			given: "a master with a consistent taxonomy",
+			setup: func(root string) {
+				addTaxonomy(root)
+				So(addFile(root, "zebedee/master/economy/inflation/bulletins/cpi/2023-02-09/data.json", []byte(`{
+					"type": "bulletin",
+					"breadcrumb": [{"uri": "/"}, {"uri": "/economy/"}, {"uri": "/economy/inflation"}]
+				}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "a master with an inconsistent taxonomy",
+			setup: func(root string) {
+				addTaxonomy(root)
+				So(addFile(root, "zebedee/master/economy/gdp/data.json", []byte(`{"type": "product_page",
+					"breadcrumb": [{"uri": "/"}, {"uri": "/economy"}],
+					"items": [{"uri": "/economy/gdp/timeseries/abmi"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/inflation/bulletins/cpi/2023-02-09/data.json", []byte(`{
+					"type": "bulletin",
+					"breadcrumb": [{"uri": "/"}, {"uri": "/economy/prices"}, {"uri": "/economy/inflation/timeseries/d7bt"}]
+				}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/master/economy/inflation/articles/cpi/2023-02-09/data.json", []byte(`{
+					"type": "article",
+					"breadcrumb": [{"uri": "/"}, {"uri": "/economy"}]
+				}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckTaxonomy,
+					Path:    "/economy/gdp/timeseries/abmi",
+					Message: "taxonomy node '/economy/gdp' references child '/economy/gdp/timeseries/abmi' missing from master",
+				},
+				{
+					Check:   checker.CheckTaxonomy,
+					Path:    "/economy/gdp",
+					Message: "product_page '/economy/gdp' not listed by its parent taxonomy node '/economy'",
+				},
+				{
+					Check: checker.CheckTaxonomy,
+					Path:  "/economy/inflation/articles/cpi/2023-02-09",
+					Message: "breadcrumb of page '/economy/inflation/articles/cpi/2023-02-09' does not match its ancestry: " +
+						"breadcrumb [/, /economy], taxonomy nodes above [/, /economy, /economy/inflation]",
+				},
+				{
+					Check: checker.CheckTaxonomy,
+					Path:  "/economy/inflation/bulletins/cpi/2023-02-09",
+					Message: "breadcrumb of page '/economy/inflation/bulletins/cpi/2023-02-09' is broken: " +
+						"'/economy/prices' missing from master, '/economy/inflation/timeseries/d7bt' is not a taxonomy node",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// uriClaim is a collection's claim on a uri, along with the states the collection holds the uri in
+type uriClaim struct {
+	collection openCollection
+	states     []string
+}
+
+// CheckURIConflicts checks that no uri is claimed by more than one collection under zebedee/collections, whether
+// listed in the collection json or held in its state dirs, as the edits of one would be lost when the other publishes.
+// With a collection scope, only conflicts involving the scoped collection are reported.
+func (c *Checker) CheckURIConflicts(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking for uris claimed by more than one collection")
+
+	collections, err := c.getOpenCollections(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	claims := make(map[string][]uriClaim)
+	for _, col := range collections {
+		states, err := c.collectionURIStates(col)
+		if err != nil {
+			return false, err
+		}
+		for uri, s := range states {
+			claims[uri] = append(claims[uri], uriClaim{collection: col, states: s})
+		}
+	}
+
+	valid := true
+	for _, uri := range slices.Sorted(maps.Keys(claims)) {
+		uriClaims := claims[uri]
+		inScope := slices.ContainsFunc(uriClaims, func(cl uriClaim) bool {
+			return c.Scope.includesCollection(cl.collection.name)
+		})
+		if len(uriClaims) < 2 || !inScope {
+			continue
+		}
+
+		valid = false
+		descriptions := make([]string, 0, len(uriClaims))
+		for _, cl := range uriClaims {
+			descriptions = append(descriptions, cl.describe())
+		}
+		c.AddFinding(Finding{
+			Check:   CheckURIConflicts,
+			Path:    uri,
+			Message: fmt.Sprintf("uri claimed by more than one collection: %s", strings.Join(descriptions, "; ")),
+		})
+	}
+
+	if !valid {
+		log.Info(ctx, "uris claimed by more than one collection")
+	}
+	return valid, nil
+}
+
+// collectionURIStates returns the states each uri within the scope is held in by a collection, whether listed in its
+// json or present in its state dirs
+func (c *Checker) collectionURIStates(col openCollection) (map[string][]string, error) {
+	states := make(map[string][]string)
+	add := func(uri, state string) {
+		if !slices.Contains(states[uri], state) {
+			states[uri] = append(states[uri], state)
+		}
+	}
+
+	for _, state := range collectionStates {
+		for _, uri := range col.collection.URIs(state) {
+			if included, _ := c.Scope.includesURI(uri); included {
+				add(uri, state)
+			}
+		}
+		files, err := c.collectionStateFiles(col.name, state)
+		if err != nil {
+			return nil, err
+		}
+		for _, uri := range files {
+			add(uri, state)
+		}
+	}
+	return states, nil
+}
+
+// describe returns the collection name, the states it holds the uri in and when it is scheduled to publish
+func (cl uriClaim) describe() string {
+	publish := "no scheduled publish date"
+	if !cl.collection.collection.PublishDate.IsZero() {
+		publish = "publishing " + cl.collection.collection.PublishDate.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("'%s' (%s, %s)", cl.collection.name, strings.Join(cl.states, ", "), publish)
+}
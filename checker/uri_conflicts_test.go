@@ -0,0 +1,78 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckURIConflicts(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	addCollections := func(ws string) {
+		So(addFile(ws, "zebedee/collections/collection1.json", []byte(`{
+			"type": "scheduled",
+			"publishDate": "2023-02-09T09:30:00.000Z",
+			"inProgressUris": ["/economy/a/data.json"],
+			"reviewedUris": ["/economy/b/data.json"]
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection1/inprogress/economy/a/data.json", []byte("{}")), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection1/reviewed/economy/b/data.json", []byte("{}")), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection2.json", []byte(`{
+			"type": "manual",
+			"reviewedUris": ["/economy/c/data.json"]
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection2/reviewed/economy/c/data.json", []byte("{}")), ShouldBeNil)
+	}
+
+	addConflicts := func(ws string) {
+		addCollections(ws)
+		So(addFile(ws, "zebedee/collections/collection3.json", []byte(`{
+			"type": "manual",
+			"completeUris": ["/economy/a/data.json"],
+			"reviewedUris": ["/economy/b/data.json"]
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection3/complete/economy/a/data.json", []byte("{}")), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection3/reviewed/economy/b/data.json", []byte("{}")), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckURIConflicts, []checkTest{
+		{
+			given: "collections claiming distinct uris",
+			setup: addCollections,
+		},
+		{
+			given: "collections claiming the same uris",
+			setup: addConflicts,
+			findings: []checker.Finding{
+				{
+					Check: checker.CheckURIConflicts,
+					Path:  "/economy/a/data.json",
+					Message: "uri claimed by more than one collection: " +
+						"'collection1' (inprogress, publishing 2023-02-09T09:30:00Z); " +
+						"'collection3' (complete, no scheduled publish date)",
+				},
+				{
+					Check: checker.CheckURIConflicts,
+					Path:  "/economy/b/data.json",
+					Message: "uri claimed by more than one collection: " +
+						"'collection1' (reviewed, publishing 2023-02-09T09:30:00Z); " +
+						"'collection3' (reviewed, no scheduled publish date)",
+				},
+			},
+		},
+		{
+			given: "collections claiming the same uris, scoped to a collection claiming none of them",
+			setup: addConflicts,
+			configure: func(chk *checker.Checker) {
+				chk.Scope = checker.Scope{Collection: "collection2"}
+			},
+		},
+	})
+}
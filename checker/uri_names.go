@@ -0,0 +1,104 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+var (
+	// uriSegmentPattern is the alphabet of a valid uri segment
+	uriSegmentPattern = regexp.MustCompile(`^[a-z0-9._-]+$`)
+	disallowedRuns    = regexp.MustCompile(`[^a-z0-9._-]+`)
+)
+
+// CheckURINames walks master for dirs whose names are not valid uri segments, reporting uppercase characters,
+// characters outside the allowed alphabet, trailing dots or whitespace, and names that collide with a sibling when case
+// is ignored. Each finding suggests the canonical form of the uri.
+func (c *Checker) CheckURINames(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking uri names in master")
+
+	valid := true
+	stack := make([]siblingNames, 0)
+
+	err := c.walkMaster(ctx, func(uri string, d fs.DirEntry) error {
+		if !d.IsDir() {
+			return nil
+		}
+
+		parent := path.Dir(uri)
+		for len(stack) > 0 && stack[len(stack)-1].uri != parent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			stack = append(stack, siblingNames{uri: parent, names: make(map[string]string)})
+		}
+		siblings := stack[len(stack)-1].names
+		stack = append(stack, siblingNames{uri: uri, names: make(map[string]string)})
+
+		name := d.Name()
+		problems := uriSegmentProblems(name)
+		lower := strings.ToLower(name)
+		if other, ok := siblings[lower]; ok {
+			problems = append(problems, fmt.Sprintf("collides with '%s' ignoring case", path.Join(parent, other)))
+		} else {
+			siblings[lower] = name
+		}
+
+		if len(problems) > 0 {
+			valid = false
+			c.AddFinding(Finding{
+				Check: CheckURINames,
+				Path:  uri,
+				Message: fmt.Sprintf("invalid uri segment '%s' in master: %s; canonical form '%s'",
+					name, strings.Join(problems, ", "), canonicalURI(uri)),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !valid {
+		log.Info(ctx, "invalid uri names found in master")
+	}
+	return valid, nil
+}
+
+// siblingNames holds the names of the dirs seen so far in a dir, keyed by their lowercase form
+type siblingNames struct {
+	uri   string
+	names map[string]string
+}
+
+func uriSegmentProblems(name string) []string {
+	problems := make([]string, 0)
+	if strings.ToLower(name) != name {
+		problems = append(problems, "uppercase characters")
+	}
+	if trimmed := strings.TrimRight(name, ". \t"); trimmed != name {
+		problems = append(problems, "trailing dots or whitespace")
+	}
+	if !uriSegmentPattern.MatchString(strings.ToLower(name)) {
+		problems = append(problems, "characters outside a-z 0-9 . _ -")
+	}
+	return problems
+}
+
+// canonicalURI lowercases each segment of the uri, replaces runs of disallowed characters with a hyphen and trims
+// trailing dots, whitespace and hyphens
+func canonicalURI(uri string) string {
+	segments := strings.Split(uri, "/")
+	for i, s := range segments {
+		s = strings.TrimRight(strings.ToLower(s), ". \t")
+		s = disallowedRuns.ReplaceAllString(s, "-")
+		segments[i] = strings.Trim(s, "-")
+	}
+	return strings.Join(segments, "/")
+}
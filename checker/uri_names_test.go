@@ -0,0 +1,63 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckURINames(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	runCheckTests(t, checker.CheckURINames, []checkTest{
+		{
+			given: "a master with valid uri names",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/inflationandpriceindices/bulletins/cpi/2023-02-09",
+					"zebedee/master/economy/grossdomesticproductgdp/timeseries/abmi_q",
+				)
+			},
+		},
+		{
+			given: "a master with invalid uri names",
+			setup: func(root string) {
+				addPages(root,
+					"zebedee/master/economy/Inflation/cpi",
+					"zebedee/master/economy/inflation/cpi",
+					"zebedee/master/economy/gdp growth.",
+					"zebedee/master/economy/café",
+				)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckURINames,
+					Path:    "/economy/Inflation",
+					Message: "invalid uri segment 'Inflation' in master: uppercase characters; canonical form '/economy/inflation'",
+				},
+				{
+					Check:   checker.CheckURINames,
+					Path:    "/economy/café",
+					Message: "invalid uri segment 'café' in master: characters outside a-z 0-9 . _ -; canonical form '/economy/caf'",
+				},
+				{
+					Check: checker.CheckURINames,
+					Path:  "/economy/gdp growth.",
+					Message: "invalid uri segment 'gdp growth.' in master: trailing dots or whitespace, " +
+						"characters outside a-z 0-9 . _ -; canonical form '/economy/gdp-growth'",
+				},
+				{
+					Check: checker.CheckURINames,
+					Path:  "/economy/inflation",
+					Message: "invalid uri segment 'inflation' in master: collides with '/economy/Inflation' " +
+						"ignoring case; canonical form '/economy/inflation'",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+// stores of the users, teams and permissions in the zebedee root
+const (
+	usersDir      = "zebedee/users"
+	teamsDir      = "zebedee/teams"
+	accessMapping = "zebedee/permissions/accessMapping.json"
+)
+
+// CheckUserStores checks the users, teams and permissions stores that publishers depend on to log in and access
+// collections. Store files must be valid JSON, team members and permitted teams and collections must exist, and every
+// user must have an email that no other user shares. Credentials held in the stores are never decoded, so can never be reported.
+func (c *Checker) CheckUserStores(ctx context.Context) (bool, error) {
+	log.Info(ctx, "checking users, teams and permissions stores")
+
+	s := &userStores{checker: c, valid: true}
+	if err := s.loadUsers(); err != nil {
+		return false, err
+	}
+	if err := s.checkTeams(); err != nil {
+		return false, err
+	}
+	if err := s.checkAccessMapping(ctx); err != nil {
+		return false, err
+	}
+
+	if !s.valid {
+		log.Info(ctx, "users, teams and permissions stores inconsistent")
+	}
+	return s.valid, nil
+}
+
+type userStores struct {
+	checker *Checker
+	valid   bool
+	emails  map[string]string // lowercase email to the store file of the user holding it
+	teams   map[int]bool
+}
+
+func (s *userStores) addFinding(file, message string) {
+	s.valid = false
+	s.checker.AddFinding(Finding{Check: CheckUserStores, Path: file, Message: message})
+}
+
+func (s *userStores) loadUsers() error {
+	s.emails = make(map[string]string)
+	return s.readStore(usersDir, func(file string) error {
+		user, err := zebedee.GetUserFromFile(path.Join(s.checker.ZebedeeRoot, file))
+		if err != nil {
+			return err
+		}
+		email := strings.ToLower(strings.TrimSpace(user.Email))
+		if email == "" {
+			s.addFinding(file, fmt.Sprintf("user in '%s' has no email", file))
+			return nil
+		}
+		if first, ok := s.emails[email]; ok {
+			s.addFinding(file, fmt.Sprintf("user in '%s' has the same email '%s' as the user in '%s'", file, user.Email, first))
+			return nil
+		}
+		s.emails[email] = file
+		return nil
+	})
+}
+
+func (s *userStores) checkTeams() error {
+	s.teams = make(map[int]bool)
+	return s.readStore(teamsDir, func(file string) error {
+		team, err := zebedee.GetTeamFromFile(path.Join(s.checker.ZebedeeRoot, file))
+		if err != nil {
+			return err
+		}
+		s.teams[team.ID] = true
+		for _, member := range team.Members {
+			if _, ok := s.emails[strings.ToLower(member)]; !ok {
+				s.addFinding(file, fmt.Sprintf("team '%s' references user '%s' missing from the users store", team.Name, member))
+			}
+		}
+		return nil
+	})
+}
+
+func (s *userStores) checkAccessMapping(ctx context.Context) error {
+	mapping, err := zebedee.GetAccessMappingFromFile(path.Join(s.checker.ZebedeeRoot, accessMapping))
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Info(ctx, "no permissions store in zebedee root")
+			return nil
+		}
+		if isDecodeError(err) {
+			s.addFinding(accessMapping, fmt.Sprintf("permissions store '%s' %s", accessMapping, describeDecodeError(err)))
+			return nil
+		}
+		return err
+	}
+
+	collections, err := s.checker.getOpenCollections(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]bool)
+	for _, col := range collections {
+		ids[col.collection.ID] = true
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(mapping.Collections)) {
+		if !ids[id] {
+			s.addFinding(accessMapping, fmt.Sprintf("permissions reference collection '%s' missing from zebedee/collections", id))
+		}
+		for _, team := range mapping.Collections[id] {
+			if !s.teams[team] {
+				s.addFinding(accessMapping, fmt.Sprintf("permissions of collection '%s' reference team %d missing from the teams store", id, team))
+			}
+		}
+	}
+	return nil
+}
+
+// readStore calls fn with the path, relative to the zebedee root, of every json file in a store dir. Files that are
+// not valid JSON are reported rather than passed to fn.
+func (s *userStores) readStore(dir string, fn func(file string) error) error {
+	filenames, err := filepath.Glob(path.Join(s.checker.ZebedeeRoot, dir, "*.json"))
+	if err != nil {
+		return errors.Wrapf(err, "unexpected error searching store '%s'", dir)
+	}
+	for _, filename := range filenames {
+		file := path.Join(dir, path.Base(filename))
+		if err := fn(file); err != nil {
+			if !isDecodeError(err) {
+				return err
+			}
+			s.addFinding(file, fmt.Sprintf("store file '%s' %s", file, describeDecodeError(err)))
+		}
+	}
+	return nil
+}
+
+// describeDecodeError describes where a store file fails to decode without quoting its content, which may include
+// credentials
+func describeDecodeError(err error) string {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Sprintf("is not valid JSON at offset %d", syntaxErr.Offset)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("has field '%s' of the wrong type", typeErr.Field)
+	}
+	return "is not valid JSON"
+}
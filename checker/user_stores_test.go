@@ -0,0 +1,90 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+func TestCheckUserStores(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	addStores := func(ws string) {
+		So(addFile(ws, "zebedee/users/a.json", []byte(`{
+			"name": "A", "email": "a@ons.gov.uk", "passwordHash": "$2a$10$abc"
+		}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/users/b.json", []byte(`{"name": "B", "email": "b@ons.gov.uk"}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/teams/1.json", []byte(`{"id": 1, "name": "Team 1", "members": ["a@ons.gov.uk"]}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/collections/collection1.json", []byte(`{"id": "collection1-abc"}`)), ShouldBeNil)
+		So(addFile(ws, "zebedee/keyring/collection1-abc.txt", []byte("secret")), ShouldBeNil)
+	}
+
+	runCheckTests(t, checker.CheckUserStores, []checkTest{
+		{
+			given: "consistent users, teams and permissions stores",
+			setup: func(root string) {
+				addStores(root)
+				So(addFile(root, "zebedee/permissions/accessMapping.json", []byte(`{
+					"administrators": ["a@ons.gov.uk"], "collections": {"collection1-abc": [1]}
+				}`)), ShouldBeNil)
+			},
+		},
+		{
+			given: "inconsistent users, teams and permissions stores",
+			setup: func(root string) {
+				addStores(root)
+				So(addFile(root, "zebedee/users/c.json", []byte(`{"name": "C", "email": "A@ons.gov.uk"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/users/d.json", []byte(`{"email": "d@ons.gov.uk", "passwordHash": "$2a$10$def`)), ShouldBeNil)
+				So(addFile(root, "zebedee/users/e.json", []byte(`{"name": "E", "email": ""}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/users/f.json", []byte(`{"name": "F"}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/teams/2.json", []byte(`{"id": 2, "name": "Team 2", "members": ["d@ons.gov.uk"]}`)), ShouldBeNil)
+				So(addFile(root, "zebedee/permissions/accessMapping.json", []byte(`{
+					"collections": {"collection1-abc": [1, 3], "collection9-xyz": [2]}
+				}`)), ShouldBeNil)
+			},
+			findings: []checker.Finding{
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/users/c.json",
+					Message: "user in 'zebedee/users/c.json' has the same email 'A@ons.gov.uk' as the user in 'zebedee/users/a.json'",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/users/d.json",
+					Message: "store file 'zebedee/users/d.json' is not valid JSON at offset 53",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/users/e.json",
+					Message: "user in 'zebedee/users/e.json' has no email",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/users/f.json",
+					Message: "user in 'zebedee/users/f.json' has no email",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/teams/2.json",
+					Message: "team 'Team 2' references user 'd@ons.gov.uk' missing from the users store",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/permissions/accessMapping.json",
+					Message: "permissions of collection 'collection1-abc' reference team 3 missing from the teams store",
+				},
+				{
+					Check:   checker.CheckUserStores,
+					Path:    "zebedee/permissions/accessMapping.json",
+					Message: "permissions reference collection 'collection9-xyz' missing from zebedee/collections",
+				},
+			},
+		},
+	})
+}
@@ -1,14 +1,34 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 // Config represents service configuration for dp-integrity-checker
 type Config struct {
-	ZebedeeRoot                string `envconfig:"ZEBEDEE_ROOT"`
-	CheckPublishedPreviousDays int    `envconfig:"CHECK_PUBLISHED_PREVIOUS_DAYS"`
-	SlackEnabled               bool   `envconfig:"SLACK_ENABLED"`
+	ZebedeeRoot                string        `envconfig:"ZEBEDEE_ROOT"`
+	CheckPublishedPreviousDays int           `envconfig:"CHECK_PUBLISHED_PREVIOUS_DAYS"`
+	SlackEnabled               bool          `envconfig:"SLACK_ENABLED"`
+	ServiceMode                bool          `envconfig:"SERVICE_MODE"`
+	BindAddr                   string        `envconfig:"BIND_ADDR"`
+	GracefulShutdownTimeout    time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT"`
+	HistoryPath                string        `envconfig:"HISTORY_PATH"`
+	ReportDir                  string        `envconfig:"REPORT_DIR"`
+	ReportBaseURL              string        `envconfig:"REPORT_BASE_URL"`
+	RunbookURL                 string        `envconfig:"RUNBOOK_URL"`
+	SuppressionsPath           string        `envconfig:"SUPPRESSIONS_PATH"`
+	EmptyAllowList             []string      `envconfig:"EMPTY_ALLOWLIST"`
+	ZebedeeUID                 int           `envconfig:"ZEBEDEE_UID"`
+	ZebedeeGID                 int           `envconfig:"ZEBEDEE_GID"`
+	SchemaVersion              string        `envconfig:"SCHEMA_VERSION"`
+	SchemaDir                  string        `envconfig:"SCHEMA_DIR"`
+	KeyStoreDir                string        `envconfig:"KEY_STORE_DIR"`
+	StaleAfter                 time.Duration `envconfig:"STALE_AFTER"`
+	SlowPublishAfter           time.Duration `envconfig:"SLOW_PUBLISH_AFTER"`
+	SlowPublishFactor          float64       `envconfig:"SLOW_PUBLISH_FACTOR"`
+	Checks                     []string      `envconfig:"CHECKS"`
 	SlackConfig                Slack
 }
 
@@ -19,6 +39,9 @@ type Slack struct {
 	AlarmEmoji   string `envconfig:"SLACK_ALARM_EMOJI"`
 }
 
+// DefaultRunbookURL is the runbook for resolving inconsistencies, linked from Slack messages and HTML reports
+const DefaultRunbookURL = "https://github.com/ONSdigital/dp-operations/blob/main/alerts/IntegrityChecker.md"
+
 var cfg *Config
 
 // Get returns the default config with any modifications through environment
@@ -37,6 +60,24 @@ func Get() (*Config, error) {
 			AlarmChannel: "#sandbox-alarm",
 			AlarmEmoji:   ":rotating_light:",
 		},
+		ServiceMode:             false,
+		BindAddr:                ":29400",
+		GracefulShutdownTimeout: 5 * time.Second,
+		HistoryPath:             "",
+		ReportDir:               "",
+		ReportBaseURL:           "",
+		RunbookURL:              DefaultRunbookURL,
+		SuppressionsPath:        "",
+		EmptyAllowList:          []string{},
+		ZebedeeUID:              -1,
+		ZebedeeGID:              -1,
+		SchemaVersion:           "v1",
+		SchemaDir:               "",
+		KeyStoreDir:             "",
+		StaleAfter:              720 * time.Hour,
+		SlowPublishAfter:        5 * time.Minute,
+		SlowPublishFactor:       10,
+		Checks:                  []string{},
 	}
 
 	return cfg, envconfig.Process("", cfg)
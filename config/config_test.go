@@ -4,6 +4,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestConfig(t *testing.T) {
@@ -31,6 +32,24 @@ func TestConfig(t *testing.T) {
 						AlarmChannel: "#sandbox-alarm",
 						AlarmEmoji:   ":rotating_light:",
 					},
+					ServiceMode:             false,
+					BindAddr:                ":29400",
+					GracefulShutdownTimeout: 5 * time.Second,
+					HistoryPath:             "",
+					ReportDir:               "",
+					ReportBaseURL:           "",
+					RunbookURL:              "https://github.com/ONSdigital/dp-operations/blob/main/alerts/IntegrityChecker.md",
+					SuppressionsPath:        "",
+					EmptyAllowList:          []string{},
+					ZebedeeUID:              -1,
+					ZebedeeGID:              -1,
+					SchemaVersion:           "v1",
+					SchemaDir:               "",
+					KeyStoreDir:             "",
+					StaleAfter:              720 * time.Hour,
+					SlowPublishAfter:        5 * time.Minute,
+					SlowPublishFactor:       10,
+					Checks:                  []string{},
 				},
 				)
 			})
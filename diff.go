@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ONSdigital/dp-integrity-checker/config"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+// runDiff compares the findings of two runs, given either as two report files or, if no files are given, as the last
+// two runs in the history store, and writes the new, persisting and resolved findings to w
+func runDiff(ctx context.Context, args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "write the diff as json")
+	flags.Usage = func() {
+		io.WriteString(flags.Output(), "usage: dp-integrity-checker diff [-json] [<earlier report> <later report>]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var earlier, later *history.Record
+	var err error
+	switch flags.NArg() {
+	case 2:
+		if earlier, err = history.ReadReport(flags.Arg(0)); err != nil {
+			return err
+		}
+		if later, err = history.ReadReport(flags.Arg(1)); err != nil {
+			return err
+		}
+	case 0:
+		if earlier, later, err = lastTwoRuns(ctx); err != nil {
+			return err
+		}
+	default:
+		flags.Usage()
+		return errors.New("diff requires two report files or none to use the history store")
+	}
+
+	diff := history.DiffResults(earlier.Result, later.Result)
+	if *asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diff)
+	}
+	return diff.WriteText(w)
+}
+
+func lastTwoRuns(ctx context.Context) (*history.Record, *history.Record, error) {
+	cfg, err := config.Get()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to retrieve service configuration")
+	}
+	if cfg.HistoryPath == "" {
+		return nil, nil, errors.New("HISTORY_PATH must be set to diff stored runs")
+	}
+
+	records, err := history.New(cfg.HistoryPath).Runs(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) < 2 {
+		return nil, nil, errors.New("history store holds fewer than two runs")
+	}
+	return &records[len(records)-2], &records[len(records)-1], nil
+}
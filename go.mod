@@ -6,8 +6,11 @@ require (
 	github.com/ONSdigital/log.go/v2 v2.5.2
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/pkg/errors v0.9.1
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.3
 	github.com/slack-go/slack v0.20.0
 	github.com/smartystreets/goconvey v1.8.1
+	go.etcd.io/bbolt v1.4.3
+	golang.org/x/text v0.26.0
 )
 
 require (
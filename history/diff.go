@@ -0,0 +1,83 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+// Diff classifies the findings of a run against those of an earlier run
+type Diff struct {
+	New        []checker.Finding `json:"new"`
+	Persisting []checker.Finding `json:"persisting"`
+	Resolved   []checker.Finding `json:"resolved"`
+}
+
+// DiffResults compares the findings of two runs by fingerprint. Findings only in the later run are new, those in both
+// are persisting and those only in the earlier run are resolved.
+func DiffResults(earlier, later checker.Result) Diff {
+	diff := Diff{
+		New:        make([]checker.Finding, 0),
+		Persisting: make([]checker.Finding, 0),
+		Resolved:   make([]checker.Finding, 0),
+	}
+
+	before := fingerprints(earlier.Findings)
+	after := fingerprints(later.Findings)
+
+	for _, f := range later.Findings {
+		if before[f.Fingerprint()] {
+			diff.Persisting = append(diff.Persisting, f)
+		} else {
+			diff.New = append(diff.New, f)
+		}
+	}
+	for _, f := range earlier.Findings {
+		if !after[f.Fingerprint()] {
+			diff.Resolved = append(diff.Resolved, f)
+		}
+	}
+	return diff
+}
+
+// WriteText writes the diff as a table, one finding per line
+func (d Diff) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%d new, %d persisting, %d resolved\n\n", len(d.New), len(d.Persisting), len(d.Resolved))
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tFINGERPRINT\tCHECK\tCOLLECTION\tPATH\tMESSAGE")
+	for _, section := range []struct {
+		status   string
+		findings []checker.Finding
+	}{
+		{"new", d.New},
+		{"persisting", d.Persisting},
+		{"resolved", d.Resolved},
+	} {
+		for _, f := range section.findings {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				section.status, f.Fingerprint(), f.Check, orDash(f.Collection), orDash(f.Path), f.Message)
+		}
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func fingerprints(findings []checker.Finding) map[string]bool {
+	fps := make(map[string]bool, len(findings))
+	for _, f := range findings {
+		fps[f.Fingerprint()] = true
+	}
+	return fps
+}
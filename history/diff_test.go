@@ -0,0 +1,41 @@
+package history_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+func TestDiffResults(t *testing.T) {
+	Convey("Given two runs sharing a reworded finding", t, func() {
+		finding3 := checker.Finding{Check: checker.CheckWorkspaceDirs, Path: "zebedee/master", Message: "inc3"}
+		earlier := checker.Result{Findings: []checker.Finding{finding1, finding2}}
+		later := checker.Result{Findings: []checker.Finding{finding1Reworded, finding3}}
+
+		Convey("When the runs are diffed", func() {
+			diff := history.DiffResults(earlier, later)
+
+			Convey("Then the findings are classified by fingerprint rather than message", func() {
+				So(diff.New, ShouldResemble, []checker.Finding{finding3})
+				So(diff.Persisting, ShouldResemble, []checker.Finding{finding1Reworded})
+				So(diff.Resolved, ShouldResemble, []checker.Finding{finding2})
+			})
+
+			Convey("Then the diff can be written as text", func() {
+				sb := &strings.Builder{}
+				So(diff.WriteText(sb), ShouldBeNil)
+				lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+				So(lines, ShouldHaveLength, 6)
+				So(lines[0], ShouldEqual, "1 new, 1 persisting, 1 resolved")
+				So(lines[3], ShouldStartWith, "new")
+				So(lines[3], ShouldContainSubstring, finding3.Fingerprint())
+				So(lines[4], ShouldStartWith, "persisting")
+				So(lines[5], ShouldStartWith, "resolved")
+			})
+		})
+	})
+}
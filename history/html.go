@@ -0,0 +1,96 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"html/template"
+	"os"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+//go:embed templates/report.html
+var templates embed.FS
+
+var reportTemplate = template.Must(template.ParseFS(templates, "templates/report.html"))
+
+type htmlReport struct {
+	Record
+	RunbookURL  string
+	Checks      []countRow
+	Collections []countRow
+	Groups      []findingGroup
+}
+
+type countRow struct {
+	Name  string
+	Count int
+}
+
+// findingGroup collects the paths of findings sharing a check, collection and message
+type findingGroup struct {
+	Check    string
+	Severity string
+	Message  string
+	Paths    []string
+}
+
+// WriteHTMLReport renders the record as a self-contained HTML report in dir, alongside the JSON report of the same
+// run, returning its path
+func WriteHTMLReport(ctx context.Context, dir, runbookURL string, rec Record) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := reportTemplate.Execute(buf, newHTMLReport(rec, runbookURL)); err != nil {
+		return "", errors.Wrap(err, "unable to render html report")
+	}
+
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", errors.Wrap(err, "unable to create report dir")
+	}
+
+	filename := reportFilename(dir, rec, ".html")
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		return "", errors.Wrap(err, "unable to write html report")
+	}
+
+	log.Info(ctx, "html report written", log.Data{"filename": filename})
+	return filename, nil
+}
+
+func newHTMLReport(rec Record, runbookURL string) htmlReport {
+	report := htmlReport{Record: rec, RunbookURL: runbookURL}
+
+	checks := make(map[string]int)
+	collections := make(map[string]int)
+	groups := make(map[[3]string]int)
+	for _, f := range rec.Result.Findings {
+		report.Checks = incrementRow(report.Checks, checks, f.Check)
+		if f.Collection != "" {
+			report.Collections = incrementRow(report.Collections, collections, f.Collection)
+		}
+
+		key := [3]string{f.Check, f.Collection, f.Message}
+		i, ok := groups[key]
+		if !ok {
+			i = len(report.Groups)
+			groups[key] = i
+			report.Groups = append(report.Groups, findingGroup{Check: f.Check, Severity: f.Severity, Message: f.Message})
+		}
+		if f.Path != "" {
+			report.Groups[i].Paths = append(report.Groups[i].Paths, f.Path)
+		}
+	}
+	return report
+}
+
+func incrementRow(rows []countRow, index map[string]int, name string) []countRow {
+	i, ok := index[name]
+	if !ok {
+		i = len(rows)
+		index[name] = i
+		rows = append(rows, countRow{Name: name})
+	}
+	rows[i].Count++
+	return rows
+}
@@ -0,0 +1,70 @@
+package history_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+func TestWriteHTMLReport(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a record of a failed run with findings and suppressed findings", t, func() {
+		tempDir, err := os.MkdirTemp("", "historytest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		rec := history.Record{
+			RanAt: run1,
+			Result: checker.Result{
+				Success:  false,
+				Findings: []checker.Finding{finding1, finding2},
+				Suppressed: []checker.SuppressedFinding{{
+					Finding: checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col0", Path: "/<legacy>"},
+					Reason:  "removed by hand",
+					Expires: "2023-03-01",
+				}},
+			},
+		}
+
+		Convey("When the html report is written", func() {
+			filename, err := history.WriteHTMLReport(context.Background(), tempDir, "https://example.com/runbook", rec)
+			So(err, ShouldBeNil)
+			body, err := os.ReadFile(filename)
+			So(err, ShouldBeNil)
+			html := string(body)
+
+			Convey("Then it is written alongside the json report", func() {
+				So(filename, ShouldEqual, path.Join(tempDir, "report-20230208T064500Z.html"))
+			})
+
+			Convey("Then it summarises the run and links to the runbook", func() {
+				So(html, ShouldContainSubstring, `<span class="status failed">Failed</span>`)
+				So(html, ShouldContainSubstring, "2 findings, 1 suppressed.")
+				So(html, ShouldContainSubstring, `<a href="https://example.com/runbook">`)
+			})
+
+			Convey("Then findings sharing a message are grouped into an expandable path list", func() {
+				So(strings.Count(html, "<details>"), ShouldEqual, 1)
+				So(html, ShouldContainSubstring, "(published-dirs, 2 paths)")
+				So(html, ShouldContainSubstring, "<li><code>/a</code></li>")
+				So(html, ShouldContainSubstring, "<li><code>/b</code></li>")
+			})
+
+			Convey("Then suppressed findings are listed with content escaped", func() {
+				So(html, ShouldContainSubstring, "removed by hand")
+				So(html, ShouldContainSubstring, "&lt;legacy&gt;")
+			})
+		})
+	})
+}
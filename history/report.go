@@ -0,0 +1,51 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+)
+
+const reportTimeFormat = "20060102T150405Z"
+
+// WriteReport writes the record as a JSON report file in dir, named by the time of the run, returning its path
+func WriteReport(ctx context.Context, dir string, rec Record) (string, error) {
+	body, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "unable to marshal report")
+	}
+
+	if err = os.MkdirAll(dir, 0750); err != nil {
+		return "", errors.Wrap(err, "unable to create report dir")
+	}
+
+	filename := reportFilename(dir, rec, ".json")
+	if err = os.WriteFile(filename, body, 0644); err != nil {
+		return "", errors.Wrap(err, "unable to write report")
+	}
+
+	log.Info(ctx, "report written", log.Data{"filename": filename})
+	return filename, nil
+}
+
+// ReadReport reads a JSON report file written by WriteReport
+func ReadReport(filename string) (*Record, error) {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var rec Record
+	if err = json.Unmarshal(body, &rec); err != nil {
+		return nil, errors.Wrapf(err, "invalid report '%s'", filename)
+	}
+	return &rec, nil
+}
+
+func reportFilename(dir string, rec Record, ext string) string {
+	return filepath.Join(dir, "report-"+rec.RanAt.UTC().Format(reportTimeFormat)+ext)
+}
@@ -0,0 +1,60 @@
+package history_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+func TestWriteReport(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a record of a failed run", t, func() {
+		tempDir, err := os.MkdirTemp("", "historytest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		rec := history.Record{
+			RanAt:  run1,
+			Result: checker.Result{Success: false, Inconsistencies: []string{"inc1"}, Findings: []checker.Finding{finding1}},
+		}
+
+		Convey("When the report is written", func() {
+			filename, err := history.WriteReport(context.Background(), path.Join(tempDir, "reports"), rec)
+
+			Convey("Then it is named by the time of the run and can be read back", func() {
+				So(err, ShouldBeNil)
+				So(filename, ShouldEqual, path.Join(tempDir, "reports", "report-20230208T064500Z.json"))
+				read, err := history.ReadReport(filename)
+				So(err, ShouldBeNil)
+				So(*read, ShouldResemble, rec)
+			})
+		})
+	})
+
+	Convey("Given a file that is not a report", t, func() {
+		tempDir, err := os.MkdirTemp("", "historytest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+		filename := path.Join(tempDir, "notareport.json")
+		So(os.WriteFile(filename, []byte("not json"), 0644), ShouldBeNil)
+
+		Convey("When the report is read", func() {
+			_, err := history.ReadReport(filename)
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "invalid report")
+			})
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package history
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+var runsBucket = []byte("runs")
+
+const defaultTimeout = 5 * time.Second
+
+// Record is a single checker run persisted in the store
+type Record struct {
+	RanAt  time.Time      `json:"ran_at"`
+	Result checker.Result `json:"result"`
+}
+
+// Store persists checker results to a BoltDB file keyed by run time. The file is opened for each operation rather
+// than held open, so that the periodic job and the service can share it.
+type Store struct {
+	Path    string
+	Timeout time.Duration
+}
+
+// New returns a Store backed by the BoltDB file at path
+func New(path string) *Store {
+	return &Store{
+		Path:    path,
+		Timeout: defaultTimeout,
+	}
+}
+
+// Record persists the result of a run started at ranAt
+func (s *Store) Record(ctx context.Context, ranAt time.Time, result *checker.Result) error {
+	logData := log.Data{"path": s.Path, "ran_at": ranAt}
+
+	body, err := json.Marshal(Record{RanAt: ranAt.UTC(), Result: *result})
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal checker result")
+	}
+
+	db, err := bolt.Open(s.Path, 0600, &bolt.Options{Timeout: s.Timeout})
+	if err != nil {
+		return errors.Wrap(err, "unable to open history store")
+	}
+	defer db.Close()
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(runsBucket)
+		if err != nil {
+			return err
+		}
+		return b.Put(key(ranAt), body)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to write to history store")
+	}
+
+	log.Info(ctx, "checker result recorded in history store", logData)
+	return nil
+}
+
+// Runs returns the records of runs between from and to inclusive, oldest first. A zero from or to leaves that end of
+// the range open.
+func (s *Store) Runs(ctx context.Context, from, to time.Time) ([]Record, error) {
+	records := make([]Record, 0)
+
+	if _, err := os.Stat(s.Path); os.IsNotExist(err) {
+		log.Info(ctx, "history store does not exist yet", log.Data{"path": s.Path})
+		return records, nil
+	}
+
+	db, err := bolt.Open(s.Path, 0600, &bolt.Options{Timeout: s.Timeout, ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open history store")
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(runsBucket)
+		if b == nil {
+			return nil
+		}
+		cur := b.Cursor()
+		k, v := cur.First()
+		if !from.IsZero() {
+			k, v = cur.Seek(key(from))
+		}
+		for ; k != nil; k, v = cur.Next() {
+			var rec Record
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return errors.Wrap(err, "unable to unmarshal history record")
+			}
+			if !to.IsZero() && rec.RanAt.After(to) {
+				break
+			}
+			records = append(records, rec)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read from history store")
+	}
+	return records, nil
+}
+
+// key encodes the run time so that byte order matches chronological order
+func key(t time.Time) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(t.UnixNano()))
+	return k
+}
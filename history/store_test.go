@@ -0,0 +1,65 @@
+package history_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+func TestStore(t *testing.T) {
+	os.Clearenv()
+	log.SetDestination(io.Discard, io.Discard) // Suppress logs for tests
+
+	Convey("Given a store in an empty directory", t, func() {
+		tempDir, err := os.MkdirTemp("", "historytest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		store := history.New(path.Join(tempDir, "history.db"))
+		ctx := context.Background()
+
+		Convey("When runs are read before any have been recorded", func() {
+			records, err := store.Runs(ctx, time.Time{}, time.Time{})
+
+			Convey("Then no records are returned", func() {
+				So(err, ShouldBeNil)
+				So(records, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When results are recorded out of order", func() {
+			day2 := time.Date(2023, 2, 9, 11, 0, 0, 0, time.UTC)
+			day1 := time.Date(2023, 2, 8, 11, 0, 0, 0, time.UTC)
+			day3 := time.Date(2023, 2, 10, 11, 0, 0, 0, time.UTC)
+			So(store.Record(ctx, day2, &checker.Result{Success: false, Inconsistencies: []string{"inc1"}}), ShouldBeNil)
+			So(store.Record(ctx, day1, &checker.Result{Success: true}), ShouldBeNil)
+			So(store.Record(ctx, day3, &checker.Result{Success: true}), ShouldBeNil)
+
+			Convey("Then all runs are returned oldest first", func() {
+				records, err := store.Runs(ctx, time.Time{}, time.Time{})
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 3)
+				So(records[0].RanAt, ShouldEqual, day1)
+				So(records[1].RanAt, ShouldEqual, day2)
+				So(records[1].Result.Inconsistencies, ShouldResemble, []string{"inc1"})
+				So(records[2].RanAt, ShouldEqual, day3)
+			})
+
+			Convey("Then runs can be read within a time range", func() {
+				records, err := store.Runs(ctx, day2, day2.Add(time.Hour))
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 1)
+				So(records[0].RanAt, ShouldEqual, day2)
+			})
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package history
+
+import (
+	"time"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+)
+
+const dateFormat = "2006-01-02"
+
+// FindingTrend describes how long a finding, identified by its fingerprint, has been reported across stored runs. The
+// finding details are those from the most recent run to report it.
+type FindingTrend struct {
+	Fingerprint string `json:"fingerprint"`
+	checker.Finding
+	FirstSeen        time.Time `json:"first_seen"`
+	LastSeen         time.Time `json:"last_seen"`
+	Runs             int       `json:"runs"`
+	Ongoing          bool      `json:"ongoing"`
+	PersistedSeconds int64     `json:"persisted_seconds"`
+}
+
+// DailyRuns counts the runs and failed runs on a single day
+type DailyRuns struct {
+	Date     string `json:"date"`
+	Runs     int    `json:"runs"`
+	Failures int    `json:"failures"`
+}
+
+// FindingTrends summarises when each finding was first and last seen in the records, which must be ordered oldest
+// first. A finding is ongoing if it was reported by the most recent run.
+func FindingTrends(records []Record) []FindingTrend {
+	trends := make([]FindingTrend, 0)
+	index := make(map[string]int)
+
+	for _, rec := range records {
+		seen := make(map[string]bool)
+		for _, f := range rec.Result.Findings {
+			fp := f.Fingerprint()
+			if seen[fp] {
+				continue
+			}
+			seen[fp] = true
+
+			i, ok := index[fp]
+			if !ok {
+				i = len(trends)
+				index[fp] = i
+				trends = append(trends, FindingTrend{Fingerprint: fp, FirstSeen: rec.RanAt})
+			}
+			trends[i].Finding = f
+			trends[i].LastSeen = rec.RanAt
+			trends[i].Runs++
+		}
+	}
+
+	for i := range trends {
+		trends[i].Ongoing = trends[i].LastSeen.Equal(records[len(records)-1].RanAt)
+		trends[i].PersistedSeconds = int64(trends[i].LastSeen.Sub(trends[i].FirstSeen) / time.Second)
+	}
+	return trends
+}
+
+// DailyRunCounts counts the runs and failures per day in the records, which must be ordered oldest first
+func DailyRunCounts(records []Record) []DailyRuns {
+	days := make([]DailyRuns, 0)
+
+	for _, rec := range records {
+		date := rec.RanAt.UTC().Format(dateFormat)
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			days = append(days, DailyRuns{Date: date})
+		}
+		days[len(days)-1].Runs++
+		if !rec.Result.Success {
+			days[len(days)-1].Failures++
+		}
+	}
+	return days
+}
@@ -0,0 +1,66 @@
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/checker"
+	"github.com/ONSdigital/dp-integrity-checker/history"
+)
+
+var (
+	run1 = time.Date(2023, 2, 8, 6, 45, 0, 0, time.UTC)
+	run2 = time.Date(2023, 2, 8, 7, 45, 0, 0, time.UTC)
+	run3 = time.Date(2023, 2, 9, 6, 45, 0, 0, time.UTC)
+
+	finding1 = checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/a", Message: "inc1"}
+	finding2 = checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/b", Message: "inc1"}
+	// finding1 reworded in a later run
+	finding1Reworded = checker.Finding{Check: checker.CheckPublishedDirs, Collection: "col1", Path: "/a", Message: "inc1 reworded"}
+
+	records = []history.Record{
+		{RanAt: run1, Result: checker.Result{Success: false, Findings: []checker.Finding{finding1, finding2}}},
+		{RanAt: run2, Result: checker.Result{Success: true}},
+		{RanAt: run3, Result: checker.Result{Success: false, Findings: []checker.Finding{finding1Reworded}}},
+	}
+)
+
+func TestFindingTrends(t *testing.T) {
+	Convey("Given records of three runs", t, func() {
+
+		Convey("When the finding trends are calculated", func() {
+			trends := history.FindingTrends(records)
+
+			Convey("Then each finding shows when it was first and last seen, matched by fingerprint", func() {
+				So(trends, ShouldResemble, []history.FindingTrend{
+					{
+						Fingerprint: finding1.Fingerprint(), Finding: finding1Reworded,
+						FirstSeen: run1, LastSeen: run3, Runs: 2, Ongoing: true, PersistedSeconds: 86400,
+					},
+					{
+						Fingerprint: finding2.Fingerprint(), Finding: finding2,
+						FirstSeen: run1, LastSeen: run1, Runs: 1, Ongoing: false, PersistedSeconds: 0,
+					},
+				})
+			})
+		})
+	})
+}
+
+func TestDailyRunCounts(t *testing.T) {
+	Convey("Given records of three runs over two days", t, func() {
+
+		Convey("When the daily run counts are calculated", func() {
+			days := history.DailyRunCounts(records)
+
+			Convey("Then the runs and failures are counted per day", func() {
+				So(days, ShouldResemble, []history.DailyRuns{
+					{Date: "2023-02-08", Runs: 2, Failures: 1},
+					{Date: "2023-02-09", Runs: 1, Failures: 1},
+				})
+			})
+		})
+	})
+}
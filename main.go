@@ -3,15 +3,20 @@ package main
 import (
 	"context"
 	"github.com/ONSdigital/dp-integrity-checker/notification"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 
 	"github.com/ONSdigital/log.go/v2/log"
 	"github.com/pkg/errors"
 
+	"github.com/ONSdigital/dp-integrity-checker/api"
 	"github.com/ONSdigital/dp-integrity-checker/checker"
 	"github.com/ONSdigital/dp-integrity-checker/config"
+	"github.com/ONSdigital/dp-integrity-checker/history"
 )
 
 const serviceName = "dp-integrity-checker"
@@ -29,6 +34,14 @@ func main() {
 	log.Namespace = serviceName
 	ctx := context.Background()
 
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(ctx, os.Args[2:], os.Stdout); err != nil {
+			log.Error(ctx, "unable to diff checker results", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(ctx); err != nil {
 		log.Fatal(ctx, "fatal runtime error", err)
 		os.Exit(1)
@@ -46,20 +59,36 @@ func run(ctx context.Context) error {
 	}
 	log.Info(ctx, "config on startup", log.Data{"config": cfg, "build_time": BuildTime, "git-commit": GitCommit})
 
-	var notifier notification.Notifier
-	if cfg.SlackEnabled {
-		notifier = &notification.SlackNotifier{
-			Config: cfg.SlackConfig,
+	newChecker := func() *checker.Checker {
+		return &checker.Checker{
+			ZebedeeRoot:                cfg.ZebedeeRoot,
+			CheckPublishedPreviousDays: cfg.CheckPublishedPreviousDays,
+			SuppressionsPath:           cfg.SuppressionsPath,
+			EmptyAllowList:             cfg.EmptyAllowList,
+			ZebedeeUID:                 cfg.ZebedeeUID,
+			ZebedeeGID:                 cfg.ZebedeeGID,
+			SchemaVersion:              cfg.SchemaVersion,
+			SchemaDir:                  cfg.SchemaDir,
+			KeyStoreDir:                cfg.KeyStoreDir,
+			StaleAfter:                 cfg.StaleAfter,
+			SlowPublishAfter:           cfg.SlowPublishAfter,
+			SlowPublishFactor:          cfg.SlowPublishFactor,
+			Checks:                     cfg.Checks,
 		}
-	} else {
-		notifier = &notification.NullNotifier{}
 	}
 
-	chk := checker.Checker{
-		ZebedeeRoot:                cfg.ZebedeeRoot,
-		CheckPublishedPreviousDays: cfg.CheckPublishedPreviousDays,
+	var store *history.Store
+	if cfg.HistoryPath != "" {
+		store = history.New(cfg.HistoryPath)
 	}
 
+	if cfg.ServiceMode {
+		return runService(ctx, cfg, newChecker, store, signals)
+	}
+
+	chk := newChecker()
+	ranAt := checker.Now()
+
 	// Run the checker in the background, using a result channel and an error channel for fatal errors
 	errChan := make(chan error, 1)
 	resultChan := make(chan *checker.Result, 1)
@@ -80,8 +109,14 @@ func run(ctx context.Context) error {
 		log.Info(ctx, "os signal received", log.Data{"signal": sig})
 	case result := <-resultChan:
 		log.Info(ctx, "integrity check result", log.Data{"Result": result})
+		if store != nil {
+			if err := store.Record(ctx, ranAt, result); err != nil {
+				log.Error(ctx, "unable to record result in history store", err)
+			}
+		}
+		reportURL := writeReports(ctx, cfg, history.Record{RanAt: ranAt, Result: *result})
 		if !result.Success {
-			err = notifier.SendCheckerResult(ctx, result)
+			err = newNotifier(cfg, reportURL).SendCheckerResult(ctx, result)
 			if err != nil {
 				log.Error(ctx, "unable to send notification of result", err)
 				return err
@@ -91,3 +126,80 @@ func run(ctx context.Context) error {
 	}
 	return nil // TODO close down the checker and confirm task completion state (err or nil)
 }
+
+// newNotifier returns the notifier to send failed results to, linking to the report at reportURL if it is not empty
+func newNotifier(cfg *config.Config, reportURL string) notification.Notifier {
+	if !cfg.SlackEnabled {
+		return &notification.NullNotifier{}
+	}
+	return &notification.SlackNotifier{
+		Config:     cfg.SlackConfig,
+		RunbookURL: cfg.RunbookURL,
+		ReportURL:  reportURL,
+	}
+}
+
+// writeReports writes the JSON and HTML reports of a run if a report dir is configured. It returns the URL of the
+// HTML report if a base URL is configured and the report was written.
+func writeReports(ctx context.Context, cfg *config.Config, rec history.Record) string {
+	if cfg.ReportDir == "" {
+		return ""
+	}
+	if _, err := history.WriteReport(ctx, cfg.ReportDir, rec); err != nil {
+		log.Error(ctx, "unable to write report", err)
+	}
+	filename, err := history.WriteHTMLReport(ctx, cfg.ReportDir, cfg.RunbookURL, rec)
+	if err != nil {
+		log.Error(ctx, "unable to write html report", err)
+		return ""
+	}
+	if cfg.ReportBaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(cfg.ReportBaseURL, "/") + "/" + filepath.Base(filename)
+}
+
+// runService serves the on-demand checker API until an os interrupt or a fatal server error occurs
+func runService(ctx context.Context, cfg *config.Config, newChecker func() *checker.Checker, store *history.Store, signals chan os.Signal) error {
+	runnerCtx, cancelRunner := context.WithCancel(ctx)
+	defer cancelRunner()
+
+	runner := api.NewRunner(newChecker)
+	runner.History = store
+	runner.Report = func(ctx context.Context, rec history.Record) {
+		writeReports(ctx, cfg, rec)
+	}
+	runner.Start(runnerCtx)
+
+	a := &api.API{Runner: runner, History: store}
+	server := &http.Server{
+		Addr:    cfg.BindAddr,
+		Handler: a.Router(),
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		log.Info(ctx, "starting http server", log.Data{"bind_addr": cfg.BindAddr})
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	// blocks until an os interrupt or a fatal error occurs
+	select {
+	case err := <-errChan:
+		log.Error(ctx, "http server error received", err)
+		return err
+	case sig := <-signals:
+		log.Info(ctx, "os signal received", log.Data{"signal": sig})
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.GracefulShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(ctx, "failed to shutdown http server gracefully", err)
+		return err
+	}
+	log.Info(ctx, "http server shutdown complete")
+	return nil
+}
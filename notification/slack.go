@@ -12,10 +12,13 @@ import (
 	"github.com/ONSdigital/dp-integrity-checker/config"
 )
 
-// SlackNotifier is a Notifier that uses the slack-go/slack.Client to send notifications
+// SlackNotifier is a Notifier that uses the slack-go/slack.Client to send notifications. The message links to the
+// RunbookURL, or the default runbook if it is not set, and to the ReportURL of a run with a published report.
 type SlackNotifier struct {
-	Config config.Slack
-	Client SlackClient
+	Config     config.Slack
+	Client     SlackClient
+	RunbookURL string
+	ReportURL  string
 }
 
 // GetClient returns the underlying slack client of this notifier, creating a new one if necessary
@@ -41,7 +44,14 @@ func (n *SlackNotifier) SendCheckerResult(ctx context.Context, result *checker.R
 		attachmentText.WriteRune('\n')
 	}
 
-	attachmentText.WriteString("Please refer to <https://github.com/ONSdigital/dp-operations/blob/main/alerts/IntegrityChecker.md|IntegrityChecker> solution to fix this issue.")
+	if n.ReportURL != "" {
+		attachmentText.WriteString(fmt.Sprintf("See the <%s|full report> for details.\n", n.ReportURL))
+	}
+	runbookURL := n.RunbookURL
+	if runbookURL == "" {
+		runbookURL = config.DefaultRunbookURL
+	}
+	attachmentText.WriteString(fmt.Sprintf("Please refer to <%s|IntegrityChecker> solution to fix this issue.", runbookURL))
 
 	attachment := slack.Attachment{
 		Pretext: fmt.Sprintf("Found %d inconsistencies during integrity check\n", len(result.Inconsistencies)),
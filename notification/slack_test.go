@@ -2,6 +2,7 @@ package notification_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -133,4 +134,74 @@ func TestSendCheckerResult_Slack(t *testing.T) {
 			})
 		})
 	})
+
+	Convey("Given a slack notifier without a runbook link", t, func() {
+		slackClientMock := &mock.SlackClientMock{
+			PostMessageFunc: func(channelID string, options ...slack.MsgOption) (string, string, error) {
+				return "", "", nil
+			},
+		}
+
+		notifier := notification.SlackNotifier{
+			Config: config.Slack{
+				UserName:     username,
+				AlarmChannel: channel,
+				AlarmEmoji:   emoji,
+			},
+			Client: slackClientMock,
+		}
+
+		Convey("When a result is sent", func() {
+			err := notifier.SendCheckerResult(context.Background(), &inconsistentResult)
+
+			Convey("Then the message should link to the default runbook", func() {
+				So(err, ShouldBeNil)
+				So(slackClientMock.PostMessageCalls(), ShouldHaveLength, 1)
+				postCall := slackClientMock.PostMessageCalls()[0]
+				_, values, err := slack.UnsafeApplyMsgOptions("", channel, "", postCall.Options...)
+				So(err, ShouldBeNil)
+				var attachments []slack.Attachment
+				So(json.Unmarshal([]byte(values.Get("attachments")), &attachments), ShouldBeNil)
+				So(attachments, ShouldHaveLength, 1)
+				So(attachments[0].Text, ShouldContainSubstring, "<"+config.DefaultRunbookURL+"|IntegrityChecker>")
+			})
+		})
+	})
+
+	Convey("Given a slack notifier with runbook and report links", t, func() {
+		slackClientMock := &mock.SlackClientMock{
+			PostMessageFunc: func(channelID string, options ...slack.MsgOption) (string, string, error) {
+				return "", "", nil
+			},
+		}
+
+		notifier := notification.SlackNotifier{
+			Config: config.Slack{
+				UserName:     username,
+				AlarmChannel: channel,
+				AlarmEmoji:   emoji,
+			},
+			Client:     slackClientMock,
+			RunbookURL: "https://example.com/runbook",
+			ReportURL:  "https://example.com/reports/report-20230209T110000Z.html",
+		}
+
+		Convey("When a result is sent", func() {
+			err := notifier.SendCheckerResult(context.Background(), &inconsistentResult)
+
+			Convey("Then the message should link to the runbook and report", func() {
+				So(err, ShouldBeNil)
+				So(slackClientMock.PostMessageCalls(), ShouldHaveLength, 1)
+				postCall := slackClientMock.PostMessageCalls()[0]
+				_, values, err := slack.UnsafeApplyMsgOptions("", channel, "", postCall.Options...)
+				So(err, ShouldBeNil)
+				var attachments []slack.Attachment
+				So(json.Unmarshal([]byte(values.Get("attachments")), &attachments), ShouldBeNil)
+				So(attachments, ShouldHaveLength, 1)
+				So(attachments[0].Text, ShouldContainSubstring, "<https://example.com/runbook|IntegrityChecker>")
+				So(attachments[0].Text, ShouldContainSubstring,
+					"<https://example.com/reports/report-20230209T110000Z.html|full report>")
+			})
+		})
+	})
 }
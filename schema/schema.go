@@ -0,0 +1,161 @@
+// Package schema validates the content of zebedee pages against JSON Schemas for their page type. Schemas are bundled
+// in versioned sets, so that rules are tightened by adding a set rather than changing an existing one.
+package schema
+
+import (
+	"cmp"
+	"embed"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// DefaultVersion is the version of the bundled schema set used unless another is configured
+const DefaultVersion = "v1"
+
+//go:embed schemas
+var bundled embed.FS
+
+var printer = message.NewPrinter(language.English)
+
+// Set is a versioned set of schemas keyed by page type
+type Set struct {
+	Version string
+	schemas map[string]*jsonschema.Schema
+}
+
+// Violation is a rule of a schema broken by a page, located by the JSON pointer of the field
+type Violation struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// Versions returns the versions of the bundled schema sets
+func Versions() []string {
+	entries, _ := fs.ReadDir(bundled, "schemas")
+	versions := make([]string, 0, len(entries))
+	for _, e := range entries {
+		versions = append(versions, e.Name())
+	}
+	return versions
+}
+
+// Load compiles the bundled schema set of the version. Schemas in dir, named '<page type>.json', replace the bundled
+// schema of the same page type or add schemas for further page types.
+func Load(version, dir string) (*Set, error) {
+	if version == "" {
+		version = DefaultVersion
+	}
+
+	sources := make(map[string]string)
+	if slices.Contains(Versions(), version) {
+		bundledDir := path.Join("schemas", version)
+		entries, err := fs.ReadDir(bundled, bundledDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			sources[strings.TrimSuffix(e.Name(), ".json")] = path.Join(bundledDir, e.Name())
+		}
+	} else if dir == "" {
+		return nil, errors.Errorf("unknown schema version '%s'", version)
+	}
+
+	overrides := make(map[string]bool)
+	if dir != "" {
+		filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		for _, filename := range filenames {
+			pageType := strings.TrimSuffix(filepath.Base(filename), ".json")
+			sources[pageType] = filename
+			overrides[pageType] = true
+		}
+	}
+
+	c := jsonschema.NewCompiler()
+	c.AssertFormat()
+	set := &Set{Version: version, schemas: make(map[string]*jsonschema.Schema)}
+	for pageType, source := range sources {
+		var f fs.File
+		var err error
+		if overrides[pageType] {
+			f, err = os.Open(source)
+		} else {
+			f, err = bundled.Open(source)
+		}
+		if err != nil {
+			return nil, err
+		}
+		doc, err := jsonschema.UnmarshalJSON(f)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode schema '%s'", source)
+		}
+
+		url := "schema:///" + version + "/" + pageType + ".json"
+		if err = c.AddResource(url, doc); err != nil {
+			return nil, errors.Wrapf(err, "unable to add schema '%s'", source)
+		}
+		if set.schemas[pageType], err = c.Compile(url); err != nil {
+			return nil, errors.Wrapf(err, "unable to compile schema '%s'", source)
+		}
+	}
+	return set, nil
+}
+
+// Validate validates a page, decoded with jsonschema.UnmarshalJSON, against the schema of its page type, returning the
+// violations ordered by field. It reports false if the set has no schema for the page type.
+func (s *Set) Validate(pageType string, doc any) ([]Violation, bool) {
+	sch, ok := s.schemas[pageType]
+	if !ok {
+		return nil, false
+	}
+
+	err := sch.Validate(doc)
+	if err == nil {
+		return []Violation{}, true
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []Violation{{Field: "", Rule: "schema", Message: err.Error()}}, true
+	}
+	v := violations(validationErr)
+	slices.SortFunc(v, func(a, b Violation) int {
+		return cmp.Or(strings.Compare(a.Field, b.Field), strings.Compare(a.Rule, b.Rule))
+	})
+	return v, true
+}
+
+// violations flattens a validation error to the rules broken at its leaves
+func violations(e *jsonschema.ValidationError) []Violation {
+	if len(e.Causes) > 0 {
+		v := make([]Violation, 0)
+		for _, cause := range e.Causes {
+			v = append(v, violations(cause)...)
+		}
+		return v
+	}
+
+	field := "/" + strings.Join(e.InstanceLocation, "/")
+	rule := strings.Join(e.ErrorKind.KeywordPath(), "/")
+	if required, ok := e.ErrorKind.(*kind.Required); ok {
+		v := make([]Violation, 0, len(required.Missing))
+		for _, missing := range required.Missing {
+			v = append(v, Violation{Field: path.Join(field, missing), Rule: rule, Message: "field missing"})
+		}
+		return v
+	}
+	return []Violation{{Field: field, Rule: rule, Message: e.ErrorKind.LocalizedString(printer)}}
+}
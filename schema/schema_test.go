@@ -0,0 +1,110 @@
+package schema_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/schema"
+)
+
+func decode(body string) any {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(body))
+	So(err, ShouldBeNil)
+	return doc
+}
+
+func TestLoad(t *testing.T) {
+	Convey("Given the bundled schema sets", t, func() {
+		So(schema.Versions(), ShouldContain, schema.DefaultVersion)
+
+		Convey("When the default set is loaded", func() {
+			set, err := schema.Load("", "")
+
+			Convey("Then it holds schemas for the page types", func() {
+				So(err, ShouldBeNil)
+				So(set.Version, ShouldEqual, schema.DefaultVersion)
+				_, ok := set.Validate("bulletin", decode(`{"type": "bulletin", "description": {"title": "CPI"}}`))
+				So(ok, ShouldBeTrue)
+				_, ok = set.Validate("unknown_page", decode(`{"type": "unknown_page"}`))
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When an unknown version is loaded", func() {
+			_, err := schema.Load("v0", "")
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a dir of schemas overriding the bundled set", t, func() {
+		dir, err := os.MkdirTemp("", "schematest")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		So(os.WriteFile(filepath.Join(dir, "bulletin.json"), []byte(`{"type": "object", "required": ["uri"]}`), 0644), ShouldBeNil)
+		So(os.WriteFile(filepath.Join(dir, "survey_page.json"), []byte(`{"type": "object"}`), 0644), ShouldBeNil)
+
+		Convey("When the set is loaded with the dir", func() {
+			set, err := schema.Load(schema.DefaultVersion, dir)
+			So(err, ShouldBeNil)
+
+			Convey("Then the schemas in the dir replace or add to the bundled schemas", func() {
+				violations, ok := set.Validate("bulletin", decode(`{"type": "bulletin"}`))
+				So(ok, ShouldBeTrue)
+				So(violations, ShouldResemble, []schema.Violation{{Field: "/uri", Rule: "required", Message: "field missing"}})
+
+				_, ok = set.Validate("survey_page", decode(`{}`))
+				So(ok, ShouldBeTrue)
+				_, ok = set.Validate("article", decode(`{}`))
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestValidate(t *testing.T) {
+	Convey("Given the default schema set", t, func() {
+		set, err := schema.Load(schema.DefaultVersion, "")
+		So(err, ShouldBeNil)
+
+		Convey("When a valid page is validated", func() {
+			violations, ok := set.Validate("timeseries", decode(`{
+				"type": "timeseries",
+				"description": {"title": "CPIH", "cdid": "L55O", "releaseDate": "2023-02-15T07:00:00.000Z"}
+			}`))
+
+			Convey("Then there are no violations", func() {
+				So(ok, ShouldBeTrue)
+				So(violations, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When an invalid page is validated", func() {
+			violations, ok := set.Validate("timeseries", decode(`{
+				"type": "timeseries",
+				"description": {"title": "CPIH", "releaseDate": "15 February 2023"},
+				"breadcrumb": [{"uri": 1}]
+			}`))
+
+			Convey("Then each violation names the field and rule", func() {
+				So(ok, ShouldBeTrue)
+				So(violations, ShouldHaveLength, 3)
+				So(violations, ShouldContain, schema.Violation{Field: "/description/cdid", Rule: "required", Message: "field missing"})
+				So(violations, ShouldContain, schema.Violation{Field: "/breadcrumb/0/uri", Rule: "type", Message: "got number, want string"})
+				fields := make([]string, 0)
+				for _, v := range violations {
+					fields = append(fields, v.Field+" "+v.Rule)
+				}
+				So(fields, ShouldContain, "/description/releaseDate format")
+			})
+		})
+	})
+}
@@ -3,12 +3,32 @@ package zebedee
 import (
 	"encoding/json"
 	"os"
-	"time"
 )
 
+// States of the content of a collection under zebedee/collections, each held in a subdir of the collection named
+// after the state
+const (
+	StateInProgress = "inprogress"
+	StateComplete   = "complete"
+	StateReviewed   = "reviewed"
+)
+
+// ApprovalComplete is the approval status of a collection approved for publishing
+const ApprovalComplete = "COMPLETE"
+
+// Collection is the content of the json of a collection being edited under zebedee/collections. The json of published
+// collections in the publish-log is modelled by PublishedCollection.
 type Collection struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Type           string          `json:"type"`
+	PublishDate    Date            `json:"publishDate"`
+	ApprovalStatus string          `json:"approvalStatus"`
+	InProgressURIs []string        `json:"inProgressUris"`
+	CompleteURIs   []string        `json:"completeUris"`
+	ReviewedURIs   []string        `json:"reviewedUris"`
 	PendingDeletes []PendingDelete `json:"pendingDeletes"`
-	PublishEndDate time.Time       `json:"publishEndDate"`
+	PublishEndDate Date            `json:"publishEndDate"`
 }
 
 type PendingDelete struct {
@@ -19,6 +39,19 @@ type PendingDeleteRoot struct {
 	URI string `json:"uri"`
 }
 
+// URIs returns the uris of the files listed by the collection in the state
+func (c *Collection) URIs(state string) []string {
+	switch state {
+	case StateInProgress:
+		return c.InProgressURIs
+	case StateComplete:
+		return c.CompleteURIs
+	case StateReviewed:
+		return c.ReviewedURIs
+	}
+	return nil
+}
+
 func GetCollectionFromFile(filename string) (*Collection, error) {
 	body, err := os.ReadFile(filename)
 	if err != nil {
@@ -0,0 +1,113 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Page is the content of a page's data.json in master
+type Page struct {
+	Type        string          `json:"type"`
+	URI         string          `json:"uri"`
+	Description PageDescription `json:"description"`
+	Breadcrumb  []Link          `json:"breadcrumb"`
+	Versions    []PageVersion   `json:"versions"`
+	Downloads   []Download      `json:"downloads"`
+	Sections    []Section       `json:"sections"`
+	Accordion   []Section       `json:"accordion"`
+	Charts      []Figure        `json:"charts"`
+	Tables      []Figure        `json:"tables"`
+	Images      []Figure        `json:"images"`
+	Items       []Link          `json:"items"`
+
+	RelatedDocuments          []Link `json:"relatedDocuments"`
+	RelatedDatasets           []Link `json:"relatedDatasets"`
+	RelatedMethodology        []Link `json:"relatedMethodology"`
+	RelatedMethodologyArticle []Link `json:"relatedMethodologyArticle"`
+	Links                     []Link `json:"links"`
+}
+
+// PageDescription holds the page metadata. The release date and state are only set on release calendar pages, the
+// date in the form '2023-02-15T07:00:00.000Z'.
+type PageDescription struct {
+	Title       string `json:"title"`
+	ReleaseDate string `json:"releaseDate"`
+	Published   bool   `json:"published"`
+	Cancelled   bool   `json:"cancelled"`
+}
+
+// PageVersion references a superseded version of a page held under its 'previous' dir
+type PageVersion struct {
+	URI              string `json:"uri"`
+	CorrectionNotice string `json:"correctionNotice"`
+	Label            string `json:"label"`
+}
+
+// Download references a downloadable file of a dataset or timeseries page, either by a file name relative to the page
+// or by uri
+type Download struct {
+	Title string `json:"title"`
+	File  string `json:"file"`
+	URI   string `json:"uri"`
+}
+
+// Section is a titled block of markdown, which may embed figures with markup such as <ons-chart path="..." />. The
+// sections of taxonomy pages instead link to a child page as their theme.
+type Section struct {
+	Title    string `json:"title"`
+	Markdown string `json:"markdown"`
+	Theme    Link   `json:"theme"`
+}
+
+// Link references another page by uri
+type Link struct {
+	URI string `json:"uri"`
+}
+
+// Figure references a chart, table or image of a page. Its assets are stored beside the page, named by the figure
+// uri or by the filename relative to the page, e.g. the figure's json is '<uri>.json'.
+type Figure struct {
+	Title    string `json:"title"`
+	Filename string `json:"filename"`
+	URI      string `json:"uri"`
+}
+
+// Image is the json of an image figure, listing the files uploaded and generated for it, which are named relative to
+// the page
+type Image struct {
+	Files []ImageFile `json:"files"`
+}
+
+// ImageFile is a file of an image figure, such as the uploaded png
+type ImageFile struct {
+	Type     string `json:"type"`
+	Filename string `json:"filename"`
+}
+
+// GetImageFromFile reads the json of an image figure
+func GetImageFromFile(filename string) (*Image, error) {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var image Image
+	if err := json.Unmarshal(body, &image); err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func GetPageFromFile(filename string) (*Page, error) {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var page Page
+
+	err = json.Unmarshal(body, &page)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
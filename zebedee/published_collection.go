@@ -0,0 +1,199 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PublishLogDateLayout is the layout of the date and time, in UTC, that prefixes the name of each collection in the
+// publish-log
+const PublishLogDateLayout = "2006-01-02-15-04"
+
+// dateLayouts are the layouts zebedee has written dates in, tried in order
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05-0700",
+	"Jan 2, 2006 3:04:05 PM",
+}
+
+// Date is a date written by zebedee in any of the layouts it has used. A null date is the zero time.
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(body []byte) error {
+	if string(body) == "null" {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(body, &s); err != nil {
+		return err
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+	return fmt.Errorf("unrecognised date %q", s)
+}
+
+// PublishedCollection is the content of a collection's json in the publish-log, recording what was published and how
+// the publish went
+type PublishedCollection struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Type             string          `json:"type"`
+	PublishDate      Date            `json:"publishDate"`
+	PublishStartDate Date            `json:"publishStartDate"`
+	PublishEndDate   Date            `json:"publishEndDate"`
+	ApprovalStatus   string          `json:"approvalStatus"`
+	PublishComplete  bool            `json:"publishComplete"`
+	ReviewedURIs     []string        `json:"reviewedUris"`
+	CompleteURIs     []string        `json:"completeUris"`
+	PendingDeletes   []PendingDelete `json:"pendingDeletes"`
+	Events           []Event         `json:"events"`
+	PublishResults   []PublishResult `json:"publishResults"`
+}
+
+// Event is a change to a collection, such as its creation, approval or publish
+type Event struct {
+	Date  Date   `json:"date"`
+	Type  string `json:"type"`
+	Email string `json:"email"`
+}
+
+// PublishResult is the outcome of publishing a collection to one of the content servers
+type PublishResult struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+// Transaction is a publish of a collection to a content server and the files it published
+type Transaction struct {
+	ID        string    `json:"id"`
+	StartDate Date      `json:"startDate"`
+	EndDate   Date      `json:"endDate"`
+	URIInfos  []URIInfo `json:"uriInfos"`
+	Errors    []string  `json:"errors"`
+}
+
+// URIInfo is a file published by a transaction
+type URIInfo struct {
+	URI    string `json:"uri"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Start  Date   `json:"start"`
+	End    Date   `json:"end"`
+}
+
+// ParsePublishLogName splits the name of a collection's dir or json in the publish-log, such as
+// 2023-02-09-07-00-collection1, into the date and time it was published and the filename of the collection. The last
+// return value is false if the name does not follow that format.
+func ParsePublishLogName(name string) (time.Time, string, bool) {
+	name = strings.TrimSuffix(name, ".json")
+	if len(name) < len(PublishLogDateLayout)+2 || name[len(PublishLogDateLayout)] != '-' {
+		return time.Time{}, "", false
+	}
+	date, err := time.Parse(PublishLogDateLayout, name[:len(PublishLogDateLayout)])
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return date, name[len(PublishLogDateLayout)+1:], true
+}
+
+// CollectionFilename returns the filename zebedee gives a collection of the given name, which is lowercased and has
+// all but letters and digits removed
+func CollectionFilename(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// DecodeWarning reports a field of a json document that was not decoded, either as it is not modelled or as its
+// value is malformed
+type DecodeWarning struct {
+	Field   string
+	Message string
+}
+
+func (w DecodeWarning) String() string {
+	return w.Field + ": " + w.Message
+}
+
+// DecodePublishedCollection decodes a publish-log collection json field by field, so that an unknown or malformed
+// field is reported as a warning and left unset rather than failing the decode. An error is only returned if the
+// document is not a json object.
+func DecodePublishedCollection(body []byte) (*PublishedCollection, []DecodeWarning, error) {
+	var col PublishedCollection
+	warnings, err := decodeTolerant(body, &col)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &col, warnings, nil
+}
+
+func GetPublishedCollectionFromFile(filename string) (*PublishedCollection, []DecodeWarning, error) {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	return DecodePublishedCollection(body)
+}
+
+// decodeTolerant decodes each field of a json object into the field of the struct pointed to by v with the same json
+// name, matched case-insensitively as by encoding/json but preferring an exact match, returning warnings for fields
+// that are unknown or fail to decode, ordered by field name
+func decodeTolerant(body []byte, v any) ([]DecodeWarning, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(v).Elem()
+	if fields == nil {
+		return nil, &json.UnmarshalTypeError{Value: "null", Type: rv.Type()}
+	}
+
+	index := make(map[string]int)
+	for i := range rv.NumField() {
+		name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("json"), ",")
+		index[name] = i
+	}
+	lookup := func(name string) (int, bool) {
+		if i, ok := index[name]; ok {
+			return i, true
+		}
+		for field, i := range index {
+			if strings.EqualFold(field, name) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+
+	warnings := make([]DecodeWarning, 0)
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		i, ok := lookup(name)
+		if !ok {
+			warnings = append(warnings, DecodeWarning{Field: name, Message: "unknown field"})
+			continue
+		}
+		field := rv.Field(i)
+		if err := json.Unmarshal(fields[name], field.Addr().Interface()); err != nil {
+			field.SetZero()
+			warnings = append(warnings, DecodeWarning{Field: name, Message: "malformed: " + err.Error()})
+		}
+	}
+	return warnings, nil
+}
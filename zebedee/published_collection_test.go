@@ -0,0 +1,167 @@
+package zebedee_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-integrity-checker/zebedee"
+)
+
+const publishedCollectionJSON = `{
+	"id": "collection1-abc",
+	"name": "Collection 1",
+	"type": "scheduled",
+	"publishDate": "2023-02-09T07:00:00.000Z",
+	"publishStartDate": "2023-02-09T07:00:01.000+0000",
+	"publishEndDate": "Feb 9, 2023 7:00:25 AM",
+	"approvalStatus": "COMPLETE",
+	"publishComplete": true,
+	"reviewedUris": ["/economy/a/data.json"],
+	"completeUris": [],
+	"pendingDeletes": [{"root": {"uri": "/economy/b"}}],
+	"events": [{"date": "2023-02-08T16:30:00.000Z", "type": "APPROVED", "email": "a@ons.gov.uk"}],
+	"publishResults": [{"transaction": {
+		"id": "tx1",
+		"startDate": "2023-02-09T07:00:02.000Z",
+		"endDate": "2023-02-09T07:00:20.000Z",
+		"uriInfos": [{"uri": "/economy/a/data.json", "action": "created", "status": "commited"}]
+	}}]
+}`
+
+func TestDecodePublishedCollection(t *testing.T) {
+	Convey("Given a publish-log collection json", t, func() {
+		Convey("When it is decoded", func() {
+			col, warnings, err := zebedee.DecodePublishedCollection([]byte(publishedCollectionJSON))
+
+			Convey("Then every field is decoded without warnings", func() {
+				So(err, ShouldBeNil)
+				So(warnings, ShouldBeEmpty)
+				So(col.ID, ShouldEqual, "collection1-abc")
+				So(col.Name, ShouldEqual, "Collection 1")
+				So(col.PublishDate.Time, ShouldEqual, time.Date(2023, 2, 9, 7, 0, 0, 0, time.UTC))
+				So(col.PublishStartDate.Equal(time.Date(2023, 2, 9, 7, 0, 1, 0, time.UTC)), ShouldBeTrue)
+				So(col.PublishEndDate.Time, ShouldEqual, time.Date(2023, 2, 9, 7, 0, 25, 0, time.UTC))
+				So(col.PublishComplete, ShouldBeTrue)
+				So(col.ReviewedURIs, ShouldResemble, []string{"/economy/a/data.json"})
+				So(col.PendingDeletes[0].Root.URI, ShouldEqual, "/economy/b")
+				So(col.Events[0].Type, ShouldEqual, "APPROVED")
+				So(col.PublishResults[0].Transaction.URIInfos[0].URI, ShouldEqual, "/economy/a/data.json")
+			})
+		})
+	})
+
+	Convey("Given a json with unknown and malformed fields", t, func() {
+		body := []byte(`{
+			"name": "Collection 1",
+			"isEncrypted": true,
+			"publishEndDate": "yesterday",
+			"reviewedUris": "/economy/a/data.json",
+			"pendingDeletes": [{"root": {"uri": "/economy/b"}}]
+		}`)
+
+		Convey("When it is decoded", func() {
+			col, warnings, err := zebedee.DecodePublishedCollection(body)
+
+			Convey("Then the other fields are decoded and the rest reported as warnings", func() {
+				So(err, ShouldBeNil)
+				So(col.Name, ShouldEqual, "Collection 1")
+				So(col.PendingDeletes, ShouldHaveLength, 1)
+				So(col.PublishEndDate.IsZero(), ShouldBeTrue)
+				So(col.ReviewedURIs, ShouldBeNil)
+				So(warnings, ShouldHaveLength, 3)
+				So(warnings[0], ShouldResemble, zebedee.DecodeWarning{Field: "isEncrypted", Message: "unknown field"})
+				So(warnings[1].Field, ShouldEqual, "publishEndDate")
+				So(warnings[1].Message, ShouldContainSubstring, "malformed")
+				So(warnings[2].Field, ShouldEqual, "reviewedUris")
+			})
+		})
+	})
+
+	Convey("Given a json with fields named in other cases", t, func() {
+		body := []byte(`{"Name": "Collection 1", "PUBLISHENDDATE": "2023-02-09T07:00:25.000Z", "publishcomplete": true}`)
+
+		Convey("When it is decoded", func() {
+			col, warnings, err := zebedee.DecodePublishedCollection(body)
+
+			Convey("Then the fields are matched ignoring case", func() {
+				So(err, ShouldBeNil)
+				So(warnings, ShouldBeEmpty)
+				So(col.Name, ShouldEqual, "Collection 1")
+				So(col.PublishEndDate.Time, ShouldEqual, time.Date(2023, 2, 9, 7, 0, 25, 0, time.UTC))
+				So(col.PublishComplete, ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a json that is not an object", t, func() {
+		for _, body := range []string{`[]`, `null`, `"collection"`, `{"name": `} {
+			Convey("When '"+body+"' is decoded", func() {
+				col, _, err := zebedee.DecodePublishedCollection([]byte(body))
+
+				Convey("Then an error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(col, ShouldBeNil)
+				})
+			})
+		}
+	})
+}
+
+func FuzzDecodePublishedCollection(f *testing.F) {
+	for _, seed := range []string{
+		publishedCollectionJSON,
+		`{}`,
+		`null`,
+		`{"publishEndDate": null, "events": {}}`,
+		`{"publishResults": [{"transaction": {"uriInfos": [{"start": 1}]}}]}`,
+		`{"name": "a", "name": 1}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		col, warnings, err := zebedee.DecodePublishedCollection(body)
+		if err != nil {
+			if col != nil || warnings != nil {
+				t.Fatalf("decode returned a collection or warnings along with error: %v", err)
+			}
+			return
+		}
+		if col == nil {
+			t.Fatal("decode returned neither a collection nor an error")
+		}
+		for _, w := range warnings {
+			if w.Message == "" {
+				t.Fatalf("warning without a message: %+v", w)
+			}
+		}
+	})
+}
+
+func TestParsePublishLogName(t *testing.T) {
+	Convey("Given the names of collections in the publish-log", t, func() {
+		Convey("Then names in the publish-log format are split into their date and collection", func() {
+			date, filename, ok := zebedee.ParsePublishLogName("2023-02-09-07-00-collection1.json")
+			So(ok, ShouldBeTrue)
+			So(date, ShouldEqual, time.Date(2023, 2, 9, 7, 0, 0, 0, time.UTC))
+			So(filename, ShouldEqual, "collection1")
+		})
+
+		Convey("Then names not in the publish-log format are rejected", func() {
+			for _, name := range []string{"collection1", "2023-02-09-collection1", "2023-02-09-07-00", "2023-02-09-07-00-", "2023-02-30-07-00-collection1"} {
+				_, _, ok := zebedee.ParsePublishLogName(name)
+				So(ok, ShouldBeFalse)
+			}
+		})
+	})
+}
+
+func TestCollectionFilename(t *testing.T) {
+	Convey("Given a collection name with capitals, spaces and punctuation", t, func() {
+		Convey("Then its filename is lowercase letters and digits", func() {
+			So(zebedee.CollectionFilename("Labour Market: Feb 2023 (v2)"), ShouldEqual, "labourmarketfeb2023v2")
+		})
+	})
+}
@@ -0,0 +1,56 @@
+package zebedee
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Redirect maps a legacy uri to the uri that replaced it, as held in a redirect table
+type Redirect struct {
+	From string
+	To   string
+	Line int
+}
+
+// RedirectSyntaxError reports a line of a redirect table that does not map one uri to another
+type RedirectSyntaxError struct {
+	Line int
+	Text string
+}
+
+func (e *RedirectSyntaxError) Error() string {
+	return fmt.Sprintf("malformed redirect on line %d: %q", e.Line, e.Text)
+}
+
+// GetRedirectsFromFile reads a redirect table, in which each line maps a legacy uri to its replacement separated by a
+// tab. Blank lines are ignored.
+func GetRedirectsFromFile(filename string) ([]Redirect, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	redirects := make([]Redirect, 0)
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(text, "\t")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, &RedirectSyntaxError{Line: line, Text: text}
+		}
+		redirects = append(redirects, Redirect{From: from, To: to, Line: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return redirects, nil
+}
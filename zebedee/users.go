@@ -0,0 +1,60 @@
+package zebedee
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// User is a user in the zebedee users store. Credentials held alongside, such as the password hash and keyring, are
+// deliberately not decoded so they cannot be reported.
+type User struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Inactive bool   `json:"inactive"`
+}
+
+// Team is a team in the zebedee teams store, with its members identified by email
+type Team struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// AccessMapping is the zebedee permissions store, granting teams access to collections by collection id
+type AccessMapping struct {
+	Administrators        []string         `json:"administrators"`
+	DigitalPublishingTeam []string         `json:"digitalPublishingTeam"`
+	Collections           map[string][]int `json:"collections"`
+}
+
+func GetUserFromFile(filename string) (*User, error) {
+	var user User
+	if err := readJSON(filename, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func GetTeamFromFile(filename string) (*Team, error) {
+	var team Team
+	if err := readJSON(filename, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func GetAccessMappingFromFile(filename string) (*AccessMapping, error) {
+	var mapping AccessMapping
+	if err := readJSON(filename, &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func readJSON(filename string, v any) error {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}